@@ -0,0 +1,45 @@
+package gojob
+
+import (
+	"context"
+	"slices"
+	"time"
+)
+
+// ArgsProvider arguments of run at t. Called before each run without explicit arguments
+type ArgsProvider func(ctx context.Context, t time.Time) []any
+
+// SetArgs set arguments of callback for runs without explicit arguments
+func (j *Job) SetArgs(args ...any) *Job {
+	j.m.Lock()
+	defer j.m.Unlock()
+	j.args = args
+	return j
+}
+
+// GetArgs get arguments of callback
+func (j *Job) GetArgs() []any {
+	j.m.Lock()
+	defer j.m.Unlock()
+	return slices.Clone(j.args)
+}
+
+// SetArgsProvider set provider of arguments for runs without explicit arguments
+// Provided arguments are passed to callback after arguments set by SetArgs
+func (j *Job) SetArgsProvider(provider ArgsProvider) *Job {
+	j.m.Lock()
+	defer j.m.Unlock()
+	j.argsProvider = provider
+	return j
+}
+
+// runArgs arguments of run at t without explicit arguments
+func (j *Job) runArgs(ctx context.Context, t time.Time) []any {
+	j.m.Lock()
+	args, provider := slices.Clone(j.args), j.argsProvider
+	j.m.Unlock()
+	if provider == nil {
+		return args
+	}
+	return append(args, provider(ctx, t)...)
+}
@@ -0,0 +1,87 @@
+package gojob
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestJob_SetArgs(t *testing.T) {
+	var got []any
+	job := NewJob("test.args", func(ctx context.Context, args ...any) error {
+		got = args
+		return nil
+	}, 0).SetArgs("tenant-1", 30)
+	ctx := context.Background()
+	now := time.Now()
+	if err := job.RunAt(ctx, now); err != nil || !slices.Equal(got, []any{"tenant-1", 30}) {
+		t.Fatal("static arguments must be passed", got)
+	}
+	job.SetArgsProvider(func(ctx context.Context, t time.Time) []any {
+		return []any{t}
+	})
+	if job.RunAt(ctx, now.Add(time.Second)); !slices.Equal(got, []any{"tenant-1", 30, now.Add(time.Second)}) {
+		t.Fatal("provided arguments must be added", got)
+	}
+	if job.RunAt(ctx, now.Add(time.Second*2), "explicit"); !slices.Equal(got, []any{"explicit"}) {
+		t.Fatal("explicit arguments must be used", got)
+	}
+	got[0] = "changed"
+	if job.Run(ctx); got[0] != "tenant-1" || len(got) != 3 || !slices.Equal(job.GetArgs(), []any{"tenant-1", 30}) {
+		t.Fatal("arguments of run must be used", got)
+	}
+}
+
+func TestGroup_Args(t *testing.T) {
+	var m sync.Mutex
+	tenants := make(map[any]int)
+	cleanup := func(ctx context.Context, args ...any) error {
+		m.Lock()
+		defer m.Unlock()
+		tenants[args[0]]++
+		return nil
+	}
+	g := NewGroup(time.Millisecond*10, GroupModeAllParallel)
+	g.AddJob(
+		NewJob("test.args.a", cleanup, time.Hour).SetArgs("a"),
+		NewJob("test.args.b", cleanup, time.Hour).SetArgs("b"),
+	)
+	ctx := context.WithValue(context.Background(), "logger", &testLogger{})
+	ctx, cancel := context.WithTimeout(ctx, time.Millisecond*50)
+	defer cancel()
+	g.Schedule(ctx)
+	m.Lock()
+	defer m.Unlock()
+	if tenants["a"] != 1 || tenants["b"] != 1 {
+		t.Fatal("each job must run with own arguments", tenants)
+	}
+}
+
+func TestGroup_ArgsProviderForbid(t *testing.T) {
+	var concurrent, maxConcurrent, calls atomic.Int32
+	g := NewGroup(time.Millisecond*10, GroupModeAllParallel)
+	g.AddJob(NewJob("test.args.forbid", func(ctx context.Context, args ...any) error {
+		calls.Add(1)
+		n := concurrent.Add(1)
+		for m := maxConcurrent.Load(); n > m && !maxConcurrent.CompareAndSwap(m, n); m = maxConcurrent.Load() {
+		}
+		time.Sleep(time.Millisecond * 20)
+		concurrent.Add(-1)
+		return nil
+	}, time.Millisecond).SetConcurrencyPolicy(ConcurrencyForbid).SetArgsProvider(func(ctx context.Context, t time.Time) []any {
+		time.Sleep(time.Millisecond * 100)
+		return []any{t}
+	}))
+	ctx := context.WithValue(context.Background(), "logger", &testLogger{})
+	ctx, cancel := context.WithTimeout(ctx, time.Millisecond*300)
+	defer cancel()
+	g.Schedule(ctx)
+	job := g.GetJob("test.args.forbid")
+	waitIdle(job)
+	if maxConcurrent.Load() != 1 || calls.Load() < 2 {
+		t.Fatal("runs with slow provider must not overlap", maxConcurrent.Load(), calls.Load())
+	}
+}
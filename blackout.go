@@ -0,0 +1,64 @@
+package gojob
+
+import "time"
+
+// BlackoutPolicy defines what happens with jobs which are due during blackout
+type BlackoutPolicy int
+
+const (
+	// BlackoutSuppress jobs are not started during blackout
+	BlackoutSuppress BlackoutPolicy = iota
+	// BlackoutDefer jobs due during blackout are started once after blackout ends
+	BlackoutDefer
+)
+
+// blackout group period when jobs can't start
+type blackout struct {
+	// Time slots of blackout
+	schedule Schedule
+	// What to do with due jobs
+	policy BlackoutPolicy
+}
+
+// AddBlackout add period when jobs of group can't start
+func (g *Group) AddBlackout(expression ScheduleExpression, policy BlackoutPolicy) error {
+	tp, err := expression.Parse()
+	if err != nil {
+		return err
+	}
+	g.AddBlackoutSchedule(tp, policy)
+	return nil
+}
+
+// AddBlackoutSchedule add period defined by schedule when jobs of group can't start
+func (g *Group) AddBlackoutSchedule(s Schedule, policy BlackoutPolicy) *Group {
+	g.m.Lock()
+	defer g.m.Unlock()
+	g.blackouts = append(g.blackouts, blackout{schedule: s, policy: policy})
+	return g
+}
+
+// ResetBlackouts remove all blackouts
+func (g *Group) ResetBlackouts() *Group {
+	g.m.Lock()
+	defer g.m.Unlock()
+	g.blackouts = nil
+	return g
+}
+
+// blackoutAt check if t is inside of blackout. If several blackouts match, defer policy wins
+func (g *Group) blackoutAt(t time.Time) (bool, BlackoutPolicy) {
+	g.m.RLock()
+	defer g.m.RUnlock()
+	var active bool
+	var policy BlackoutPolicy
+	for i := range g.blackouts {
+		if g.blackouts[i].schedule.Match(t) {
+			active = true
+			if g.blackouts[i].policy == BlackoutDefer {
+				policy = BlackoutDefer
+			}
+		}
+	}
+	return active, policy
+}
@@ -0,0 +1,84 @@
+package gojob
+
+import (
+	"context"
+	"log"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestGroup_Blackout(t *testing.T) {
+	start := time.Now()
+	window := ScheduleFunc(func(t time.Time) bool {
+		return t.Before(start.Add(time.Millisecond * 200))
+	})
+	early := ScheduleFunc(func(t time.Time) bool {
+		return t.Before(start.Add(time.Millisecond * 100))
+	})
+	run := func(policy BlackoutPolicy) (regular, deferred, ignored int64) {
+		g := NewGroup(time.Millisecond*10, GroupModeConsistently)
+		g.AddBlackoutSchedule(window, policy)
+		g.AddJob(
+			NewJob("regular", func(ctx context.Context, args ...any) error {
+				if time.Now().Before(start.Add(time.Millisecond * 200)) {
+					t.Error("regular job must not run during blackout")
+				}
+				atomic.AddInt64(&regular, 1)
+				return nil
+			}, time.Millisecond*20),
+			NewJob("early", func(ctx context.Context, args ...any) error {
+				atomic.AddInt64(&deferred, 1)
+				return nil
+			}, time.Millisecond).SetSchedule(early),
+			NewJob("maintenance", func(ctx context.Context, args ...any) error {
+				atomic.AddInt64(&ignored, 1)
+				return nil
+			}, time.Millisecond).SetSchedule(early).SetIgnoreBlackout(true),
+		)
+		ctx := context.WithValue(context.Background(), "logger", log.Default())
+		ctx, cancel := context.WithTimeout(ctx, time.Millisecond*400)
+		defer cancel()
+		g.Schedule(ctx)
+		return
+	}
+	t.Run("suppress", func(t *testing.T) {
+		start = time.Now()
+		regular, deferred, ignored := run(BlackoutSuppress)
+		if regular == 0 {
+			t.Fatal("regular job must run after blackout")
+		}
+		if deferred != 0 {
+			t.Fatal("early job must be suppressed", deferred)
+		}
+		if ignored == 0 {
+			t.Fatal("job must ignore blackout")
+		}
+	})
+	t.Run("defer", func(t *testing.T) {
+		start = time.Now()
+		_, deferred, _ := run(BlackoutDefer)
+		if deferred != 1 {
+			t.Fatal("early job must run once after blackout", deferred)
+		}
+	})
+}
+
+func TestGroup_AddBlackout(t *testing.T) {
+	g := NewGroup(time.Second, GroupModeConsistently)
+	if err := g.AddBlackout("- - - 1 0 - - - -", BlackoutSuppress); err != nil {
+		t.Fatal(err)
+	}
+	if active, _ := g.blackoutAt(time.Date(2026, 10, 18, 1, 30, 0, 0, time.UTC)); !active {
+		t.Fatal("sunday 01:30 must be in blackout")
+	}
+	if active, _ := g.blackoutAt(time.Date(2026, 10, 19, 1, 30, 0, 0, time.UTC)); active {
+		t.Fatal("monday must not be in blackout")
+	}
+	if err := g.AddBlackout("- //* - - - - - - -", BlackoutSuppress); err == nil {
+		t.Fatal("must be parse error")
+	}
+	if active, _ := g.ResetBlackouts().blackoutAt(time.Date(2026, 10, 18, 1, 30, 0, 0, time.UTC)); active {
+		t.Fatal("blackouts must be removed")
+	}
+}
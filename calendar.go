@@ -0,0 +1,346 @@
+package gojob
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"io"
+	"os"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// calendar date key format
+	calendarDateLayout = "2006-01-02"
+	// calendar annual date key format
+	calendarAnnualLayout = "01-02"
+	// ics date format
+	calendarICSLayout = "20060102"
+)
+
+// Calendar business days and holidays
+type Calendar struct {
+	// holidays by date
+	holidays map[string]string
+	// holidays repeated every year by month and day
+	annual map[string]string
+	// non-business days of week
+	weekend []time.Weekday
+	// time zone of calendar dates. if nil the location of checked time is used
+	location *time.Location
+	// calendar must be thread safe
+	m sync.RWMutex
+}
+
+// date convert time to calendar time zone
+func (c *Calendar) date(t time.Time) time.Time {
+	if c.location != nil {
+		return t.In(c.location)
+	}
+	return t
+}
+
+// AddHoliday add holiday on the date
+func (c *Calendar) AddHoliday(date time.Time, name string) *Calendar {
+	c.m.Lock()
+	defer c.m.Unlock()
+	c.holidays[date.Format(calendarDateLayout)] = name
+	return c
+}
+
+// AddAnnualHoliday add holiday repeated every year on the month and day
+func (c *Calendar) AddAnnualHoliday(month time.Month, day int, name string) *Calendar {
+	c.m.Lock()
+	defer c.m.Unlock()
+	c.annual[time.Date(2000, month, day, 0, 0, 0, 0, time.UTC).Format(calendarAnnualLayout)] = name
+	return c
+}
+
+// SetWeekend set non-business days of week
+func (c *Calendar) SetWeekend(days ...time.Weekday) *Calendar {
+	c.m.Lock()
+	defer c.m.Unlock()
+	c.weekend = days
+	return c
+}
+
+// HolidayName get holiday name. Empty string if t is not a holiday
+func (c *Calendar) HolidayName(t time.Time) string {
+	name, _ := c.holiday(c.date(t))
+	return name
+}
+
+// IsHoliday check if t is a holiday
+func (c *Calendar) IsHoliday(t time.Time) bool {
+	_, ok := c.holiday(c.date(t))
+	return ok
+}
+
+// holiday find holiday on the date
+func (c *Calendar) holiday(date time.Time) (string, bool) {
+	c.m.RLock()
+	defer c.m.RUnlock()
+	if name, ok := c.holidays[date.Format(calendarDateLayout)]; ok {
+		return name, true
+	}
+	name, ok := c.annual[date.Format(calendarAnnualLayout)]
+	return name, ok
+}
+
+// IsBusinessDay check if t is not a weekend and not a holiday
+func (c *Calendar) IsBusinessDay(t time.Time) bool {
+	return c.isBusinessDate(c.date(t))
+}
+
+// isBusinessDate check date in calendar time zone
+func (c *Calendar) isBusinessDate(date time.Time) bool {
+	c.m.RLock()
+	weekend := slices.Contains(c.weekend, date.Weekday())
+	c.m.RUnlock()
+	if weekend {
+		return false
+	}
+	_, ok := c.holiday(date)
+	return !ok
+}
+
+// NextBusinessDay first business day on or after t with the same clock time
+func (c *Calendar) NextBusinessDay(t time.Time) time.Time {
+	date := c.date(t)
+	for i := 0; i < 366 && !c.isBusinessDate(date); i++ {
+		date = date.AddDate(0, 0, 1)
+	}
+	return date
+}
+
+// BusinessDayOfMonth number of business day in month. 0 if t is not a business day
+func (c *Calendar) BusinessDayOfMonth(t time.Time) int {
+	date := c.date(t)
+	if !c.isBusinessDate(date) {
+		return 0
+	}
+	var n int
+	for d := date.AddDate(0, 0, 1-date.Day()); d.Day() <= date.Day() && d.Month() == date.Month(); d = d.AddDate(0, 0, 1) {
+		if c.isBusinessDate(d) {
+			n++
+		}
+	}
+	return n
+}
+
+// BusinessDaysInMonth count of business days in month of t
+func (c *Calendar) BusinessDaysInMonth(t time.Time) int {
+	date := c.date(t)
+	var n int
+	for d := date.AddDate(0, 0, 1-date.Day()); d.Month() == date.Month(); d = d.AddDate(0, 0, 1) {
+		if c.isBusinessDate(d) {
+			n++
+		}
+	}
+	return n
+}
+
+// Condition condition is true when time of check is a business day
+func (c *Calendar) Condition() Condition {
+	return NewCondition(OperatorAND).AddNamedContextExpression("business day", func(ctx context.Context, t time.Time) (bool, error) {
+		return c.IsBusinessDay(t), nil
+	})
+}
+
+// NthBusinessDay schedule on n-th business day of month
+// n - number of business day, negative value counts from the end of month (-1 is the last business day)
+// tp - intraday time of start, day parts are also applied if defined
+func (c *Calendar) NthBusinessDay(n int, tp TimePart) Schedule {
+	return businessDay{calendar: c, n: n, timePart: tp}
+}
+
+// Skip schedule does not match on non-business days
+func (c *Calendar) Skip(s Schedule) Schedule {
+	return calendarSkip{calendar: c, schedule: s}
+}
+
+// Shift schedule slots on non-business days are moved to the next business day with the same clock time
+func (c *Calendar) Shift(s Schedule) Schedule {
+	return calendarShift{calendar: c, schedule: s}
+}
+
+// Parse read holidays from simple text format
+// Each line contains date and optional holiday name: "2026-01-01 New year"
+// Annual holidays are defined by month and day: "12-25 Christmas"
+// Empty lines and lines started with # are ignored
+func (c *Calendar) Parse(r io.Reader) error {
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || line[0] == '#' {
+			continue
+		}
+		date, name, _ := strings.Cut(line, " ")
+		name = strings.TrimSpace(name)
+		if d, err := time.Parse(calendarDateLayout, date); err == nil {
+			c.AddHoliday(d, name)
+		} else if d, err := time.Parse(calendarAnnualLayout, date); err == nil {
+			c.AddAnnualHoliday(d.Month(), d.Day(), name)
+		} else {
+			return errors.New("calendar: wrong date '" + date + "'")
+		}
+	}
+	return scanner.Err()
+}
+
+// ParseICS read holidays from iCalendar events
+// All days from DTSTART to DTEND are holidays, events with RRULE:FREQ=YEARLY are annual holidays
+// Other recurrence rules are not supported
+func (c *Calendar) ParseICS(r io.Reader) error {
+	var lines []string
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimRight(scanner.Text(), "\r")
+		if len(line) > 0 && (line[0] == ' ' || line[0] == '\t') && len(lines) > 0 {
+			lines[len(lines)-1] += line[1:]
+			continue
+		}
+		lines = append(lines, line)
+	}
+	if err := scanner.Err(); err != nil {
+		return err
+	}
+	var inEvent, yearly bool
+	var start, end time.Time
+	var summary string
+	for _, line := range lines {
+		key, value, ok := strings.Cut(line, ":")
+		if !ok {
+			continue
+		}
+		key, _, _ = strings.Cut(strings.ToUpper(key), ";")
+		switch {
+		case key == "BEGIN" && value == "VEVENT":
+			inEvent, yearly = true, false
+			start, end, summary = time.Time{}, time.Time{}, ""
+		case !inEvent:
+			continue
+		case key == "DTSTART" || key == "DTEND":
+			if len(value) < len(calendarICSLayout) {
+				return errors.New("calendar: wrong ics date '" + value + "'")
+			}
+			d, err := time.Parse(calendarICSLayout, value[:len(calendarICSLayout)])
+			if err != nil {
+				return err
+			}
+			if key == "DTSTART" {
+				start = d
+			} else {
+				end = d
+			}
+		case key == "SUMMARY":
+			summary = strings.NewReplacer(`\,`, ",", `\;`, ";", `\n`, " ", `\N`, " ", `\\`, `\`).Replace(value)
+		case key == "RRULE":
+			yearly = strings.Contains(strings.ToUpper(value), "FREQ=YEARLY")
+		case key == "END" && value == "VEVENT":
+			inEvent = false
+			if start.IsZero() {
+				return errors.New("calendar: event '" + summary + "' without DTSTART")
+			}
+			if yearly {
+				c.AddAnnualHoliday(start.Month(), start.Day(), summary)
+				continue
+			}
+			c.AddHoliday(start, summary)
+			for d := start.AddDate(0, 0, 1); d.Before(end); d = d.AddDate(0, 0, 1) {
+				c.AddHoliday(d, summary)
+			}
+		}
+	}
+	return nil
+}
+
+// LoadFile read holidays from simple text file
+func (c *Calendar) LoadFile(path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	return c.Parse(f)
+}
+
+// LoadICS read holidays from .ics file
+func (c *Calendar) LoadICS(path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	return c.ParseICS(f)
+}
+
+// NewCalendar create calendar with saturday and sunday as weekend
+// location - time zone of calendar dates, if nil the location of checked time is used
+func NewCalendar(location *time.Location) *Calendar {
+	return &Calendar{
+		holidays: make(map[string]string),
+		annual:   make(map[string]string),
+		weekend:  []time.Weekday{time.Saturday, time.Sunday},
+		location: location,
+	}
+}
+
+// businessDay schedule on n-th business day of month
+type businessDay struct {
+	calendar *Calendar
+	n        int
+	timePart TimePart
+}
+
+// Match check if t is n-th business day and matches time part
+func (b businessDay) Match(t time.Time) bool {
+	if !b.timePart.Match(t) {
+		return false
+	}
+	n := b.calendar.BusinessDayOfMonth(t)
+	if n == 0 {
+		return false
+	}
+	if b.n < 0 {
+		return n == b.calendar.BusinessDaysInMonth(t)+b.n+1
+	}
+	return n == b.n
+}
+
+// calendarSkip schedule without non-business days
+type calendarSkip struct {
+	calendar *Calendar
+	schedule Schedule
+}
+
+// Match check if t is business day and matches schedule
+func (s calendarSkip) Match(t time.Time) bool {
+	return s.calendar.IsBusinessDay(t) && s.schedule.Match(t)
+}
+
+// calendarShift schedule with slots moved from non-business days
+type calendarShift struct {
+	calendar *Calendar
+	schedule Schedule
+}
+
+// Match check if t is business day and schedule matches t or the same clock time on preceding non-business days
+func (s calendarShift) Match(t time.Time) bool {
+	date := s.calendar.date(t)
+	if !s.calendar.isBusinessDate(date) {
+		return false
+	}
+	if s.schedule.Match(t) {
+		return true
+	}
+	for i, d := 0, date.AddDate(0, 0, -1); i < 366 && !s.calendar.isBusinessDate(d); i, d = i+1, d.AddDate(0, 0, -1) {
+		if s.schedule.Match(d) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,156 @@
+package gojob
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+const testICS = "BEGIN:VCALENDAR\r\n" +
+	"VERSION:2.0\r\n" +
+	"BEGIN:VEVENT\r\n" +
+	"DTSTART;VALUE=DATE:20261224\r\n" +
+	"DTEND;VALUE=DATE:20261227\r\n" +
+	"SUMMARY:Christmas\\, bank\r\n" +
+	"  holidays\r\n" +
+	"END:VEVENT\r\n" +
+	"BEGIN:VEVENT\r\n" +
+	"DTSTART;VALUE=DATE:20200101\r\n" +
+	"RRULE:FREQ=YEARLY\r\n" +
+	"SUMMARY:New year\r\n" +
+	"END:VEVENT\r\n" +
+	"END:VCALENDAR\r\n"
+
+func TestCalendar_Parse(t *testing.T) {
+	t.Run("text", func(t *testing.T) {
+		c := NewCalendar(time.UTC)
+		err := c.Parse(strings.NewReader("# holidays\n2026-10-05 Bank holiday\n\n12-25 Christmas\n"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if c.HolidayName(time.Date(2026, 10, 5, 10, 0, 0, 0, time.UTC)) != "Bank holiday" {
+			t.Fatal("must be bank holiday")
+		}
+		if !c.IsHoliday(time.Date(2030, 12, 25, 0, 0, 0, 0, time.UTC)) {
+			t.Fatal("christmas must be annual")
+		}
+		if c.IsHoliday(time.Date(2027, 10, 5, 0, 0, 0, 0, time.UTC)) {
+			t.Fatal("must not be a holiday")
+		}
+	})
+	t.Run("text_error", func(t *testing.T) {
+		err := NewCalendar(time.UTC).Parse(strings.NewReader("2026-13-05 Wrong"))
+		if err == nil {
+			t.Fatal("must be an error")
+		}
+	})
+	t.Run("ics", func(t *testing.T) {
+		c := NewCalendar(time.UTC)
+		err := c.ParseICS(strings.NewReader(testICS))
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, day := range []int{24, 25, 26} {
+			if c.HolidayName(time.Date(2026, 12, day, 0, 0, 0, 0, time.UTC)) != "Christmas, bank holidays" {
+				t.Fatal("must be christmas", day)
+			}
+		}
+		if c.IsHoliday(time.Date(2026, 12, 27, 0, 0, 0, 0, time.UTC)) {
+			t.Fatal("dtend must be exclusive")
+		}
+		if !c.IsHoliday(time.Date(2027, 1, 1, 0, 0, 0, 0, time.UTC)) {
+			t.Fatal("new year must be annual")
+		}
+	})
+	t.Run("load", func(t *testing.T) {
+		dir := t.TempDir()
+		text, ics := filepath.Join(dir, "holidays.txt"), filepath.Join(dir, "holidays.ics")
+		if err := os.WriteFile(text, []byte("2026-10-05 Bank holiday\n"), 0o600); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(ics, []byte(testICS), 0o600); err != nil {
+			t.Fatal(err)
+		}
+		c := NewCalendar(time.UTC)
+		if err := c.LoadFile(text); err != nil {
+			t.Fatal(err)
+		}
+		if err := c.LoadICS(ics); err != nil {
+			t.Fatal(err)
+		}
+		if !c.IsHoliday(time.Date(2026, 10, 5, 0, 0, 0, 0, time.UTC)) || !c.IsHoliday(time.Date(2026, 12, 24, 0, 0, 0, 0, time.UTC)) {
+			t.Fatal("holidays must be loaded")
+		}
+		if err := c.LoadFile(filepath.Join(dir, "unknown.txt")); err == nil {
+			t.Fatal("must be an error")
+		}
+	})
+}
+
+func TestCalendar_BusinessDay(t *testing.T) {
+	c := NewCalendar(time.UTC).AddHoliday(time.Date(2026, 10, 5, 0, 0, 0, 0, time.UTC), "Bank holiday")
+	if !c.IsBusinessDay(time.Date(2026, 10, 2, 0, 0, 0, 0, time.UTC)) {
+		t.Fatal("friday must be a business day")
+	}
+	if c.IsBusinessDay(time.Date(2026, 10, 3, 0, 0, 0, 0, time.UTC)) {
+		t.Fatal("saturday must not be a business day")
+	}
+	if n := c.BusinessDayOfMonth(time.Date(2026, 10, 6, 0, 0, 0, 0, time.UTC)); n != 3 {
+		t.Fatal("october 6 must be the 3rd business day", n)
+	}
+	if n := c.BusinessDayOfMonth(time.Date(2026, 10, 5, 0, 0, 0, 0, time.UTC)); n != 0 {
+		t.Fatal("holiday must not be a business day", n)
+	}
+	next := c.NextBusinessDay(time.Date(2026, 10, 3, 9, 30, 0, 0, time.UTC))
+	if !next.Equal(time.Date(2026, 10, 6, 9, 30, 0, 0, time.UTC)) {
+		t.Fatal("wrong next business day", next)
+	}
+	cond := c.Condition()
+	if ok, err := cond.Evaluate(context.Background(), time.Date(2026, 10, 5, 10, 0, 0, 0, time.UTC)); ok || err != nil {
+		t.Fatal("condition must be false on holiday", err)
+	}
+	if e := cond.Explain(time.Date(2026, 10, 6, 10, 0, 0, 0, time.UTC)); !e.Result || e.Children[0].Name != "business day" {
+		t.Fatal("condition must be true on business day", e)
+	}
+}
+
+func TestCalendar_Schedule(t *testing.T) {
+	c := NewCalendar(time.UTC).AddAnnualHoliday(time.December, 25, "Christmas")
+	t.Run("nth_business_day", func(t *testing.T) {
+		s := c.NthBusinessDay(3, mustParse(t, "- - 0 9 - - - - -"))
+		if !s.Match(time.Date(2026, 10, 5, 9, 0, 10, 0, time.UTC)) {
+			t.Fatal("must match 3rd business day")
+		}
+		if s.Match(time.Date(2026, 10, 5, 10, 0, 10, 0, time.UTC)) || s.Match(time.Date(2026, 10, 6, 9, 0, 10, 0, time.UTC)) {
+			t.Fatal("must not match")
+		}
+		last := c.NthBusinessDay(-1, TimePart{})
+		if !last.Match(time.Date(2026, 10, 30, 0, 0, 0, 0, time.UTC)) || last.Match(time.Date(2026, 10, 29, 0, 0, 0, 0, time.UTC)) {
+			t.Fatal("october 30 must be the last business day")
+		}
+	})
+	t.Run("skip", func(t *testing.T) {
+		s := c.Skip(mustParse(t, "- - - 9 - 25 - - -"))
+		if s.Match(time.Date(2026, 12, 25, 9, 0, 0, 0, time.UTC)) {
+			t.Fatal("holiday must be skipped")
+		}
+		if !s.Match(time.Date(2026, 11, 25, 9, 0, 0, 0, time.UTC)) {
+			t.Fatal("business day must match")
+		}
+	})
+	t.Run("shift", func(t *testing.T) {
+		s := c.Shift(mustParse(t, "- - - 9 - 25 - - -"))
+		if s.Match(time.Date(2026, 12, 25, 9, 0, 0, 0, time.UTC)) {
+			t.Fatal("holiday must be skipped")
+		}
+		if !s.Match(time.Date(2026, 12, 28, 9, 0, 0, 0, time.UTC)) {
+			t.Fatal("slot must be shifted to monday")
+		}
+		if s.Match(time.Date(2026, 12, 29, 9, 0, 0, 0, time.UTC)) || s.Match(time.Date(2026, 12, 28, 10, 0, 0, 0, time.UTC)) {
+			t.Fatal("must not match")
+		}
+	})
+}
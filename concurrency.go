@@ -0,0 +1,131 @@
+package gojob
+
+import (
+	"context"
+	"time"
+)
+
+// ConcurrencyPolicy what to do when job is due while previous run is in progress
+type ConcurrencyPolicy uint8
+
+const (
+	// ConcurrencyAllow new run starts in parallel with previous run
+	ConcurrencyAllow ConcurrencyPolicy = iota
+	// ConcurrencyForbid new run is skipped while previous run is in progress
+	ConcurrencyForbid
+	// ConcurrencyReplace context of previous run is cancelled and new run starts
+	ConcurrencyReplace
+	// ConcurrencyQueue new run starts after previous run. Only one run is queued
+	ConcurrencyQueue
+)
+
+// jobRun run of job in progress
+type jobRun struct {
+	// start time of the run
+	start time.Time
+	// cancel context of the run. nil until the run is started
+	cancel context.CancelFunc
+	// run is replaced before start. Context is cancelled on start
+	cancelled bool
+	// closed when run is finished
+	done chan struct{}
+	// outcome of the run is saved on timeout before callback returns
+	timedOut bool
+	// run is cancelled by the new run. Outcome of the run is not saved
+	replaced bool
+	// sequence number of the run. Only the last reserved run is retried
+	seq uint
+}
+
+// stop cancel context of the run without lock
+func (r *jobRun) stop() {
+	if r.cancel != nil {
+		r.cancel()
+	} else {
+		r.cancelled = true
+	}
+}
+
+// SetConcurrencyPolicy set what to do when job is due while previous run is in progress
+func (j *Job) SetConcurrencyPolicy(policy ConcurrencyPolicy) *Job {
+	j.m.Lock()
+	defer j.m.Unlock()
+	j.concurrencyPolicy = policy
+	return j
+}
+
+// GetConcurrencyPolicy get concurrency policy
+func (j *Job) GetConcurrencyPolicy() ConcurrencyPolicy {
+	j.m.Lock()
+	defer j.m.Unlock()
+	return j.concurrencyPolicy
+}
+
+// acquire apply concurrency policy to the new run and reserve the run
+// ok is false if run must be skipped. previous is the run in progress which the new run must wait for
+func (j *Job) acquire() (run *jobRun, previous *jobRun, ok bool) {
+	j.om.Lock()
+	defer j.om.Unlock()
+	if j.running > 0 {
+		switch j.concurrencyPolicy {
+		case ConcurrencyForbid:
+			return nil, nil, false
+		case ConcurrencyReplace:
+			if j.current != nil {
+				j.current.replaced = true
+				j.current.stop()
+			}
+		case ConcurrencyQueue:
+			if j.queued {
+				return nil, nil, false
+			}
+			j.queued = true
+			previous = j.current
+		}
+	}
+	return j.reserve(), previous, true
+}
+
+// reserve count the new run as running without lock
+func (j *Job) reserve() *jobRun {
+	j.seq++
+	run := &jobRun{done: make(chan struct{}), seq: j.seq}
+	j.running++
+	j.current = run
+	return run
+}
+
+// release reserved run which is not started
+func (j *Job) release(run *jobRun) {
+	j.om.Lock()
+	defer j.om.Unlock()
+	close(run.done)
+	if j.current == run {
+		j.current = nil
+	}
+	j.running--
+	j.queued = false
+}
+
+// waitRun wait for the end of previous run
+func (j *Job) waitRun(ctx context.Context, previous *jobRun) error {
+	if previous == nil {
+		return nil
+	}
+	select {
+	case <-previous.done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// isBusy check if new run is skipped because of run in progress
+func (j *Job) isBusy() bool {
+	j.om.RLock()
+	defer j.om.RUnlock()
+	if j.running == 0 {
+		return false
+	}
+	return j.concurrencyPolicy == ConcurrencyForbid || (j.concurrencyPolicy == ConcurrencyQueue && j.queued)
+}
@@ -0,0 +1,173 @@
+package gojob
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestJob_SetConcurrencyPolicy(t *testing.T) {
+	ctx := context.Background()
+	// start job in background and wait until it is running
+	start := func(job *Job, t time.Time) chan error {
+		done := make(chan error, 1)
+		go func() {
+			done <- job.RunAt(ctx, t)
+		}()
+		for !job.IsRunning() {
+			time.Sleep(time.Millisecond)
+		}
+		return done
+	}
+	t.Run("forbid", func(t *testing.T) {
+		var calls atomic.Int32
+		release := make(chan struct{})
+		job := NewJob("test.forbid", func(ctx context.Context, args ...any) error {
+			calls.Add(1)
+			<-release
+			return nil
+		}, 0).SetConcurrencyPolicy(ConcurrencyForbid)
+		now := time.Now()
+		done := start(job, now)
+		if reason := job.SkipReason(now.Add(time.Second)); reason != "previous run is in progress" {
+			t.Fatal("wrong skip reason", reason)
+		}
+		if err := job.RunAt(ctx, now.Add(time.Second)); err != nil || calls.Load() != 1 || job.GetRuns() != 1 {
+			t.Fatal("run must be skipped", calls.Load())
+		}
+		close(release)
+		<-done
+		if err := job.RunAt(ctx, now.Add(time.Second*2)); err != nil || calls.Load() != 2 {
+			t.Fatal("job must run after previous run", calls.Load())
+		}
+	})
+	t.Run("replace", func(t *testing.T) {
+		var cancelled atomic.Bool
+		var calls atomic.Int32
+		job := NewJob("test.replace", func(ctx context.Context, args ...any) error {
+			if calls.Add(1) > 1 {
+				return nil
+			}
+			<-ctx.Done()
+			cancelled.Store(true)
+			return ctx.Err()
+		}, 0).SetConcurrencyPolicy(ConcurrencyReplace)
+		now := time.Now()
+		done := start(job, now)
+		if err := job.RunAt(ctx, now.Add(time.Second)); err != nil {
+			t.Fatal(err)
+		}
+		if err := <-done; err == nil || !cancelled.Load() {
+			t.Fatal("previous run must be cancelled")
+		}
+	})
+	t.Run("queue", func(t *testing.T) {
+		var calls, concurrent, maxConcurrent atomic.Int32
+		release := make(chan struct{})
+		job := NewJob("test.queue", func(ctx context.Context, args ...any) error {
+			if n := concurrent.Add(1); n > maxConcurrent.Load() {
+				maxConcurrent.Store(n)
+			}
+			defer concurrent.Add(-1)
+			if calls.Add(1) == 1 {
+				<-release
+			}
+			return nil
+		}, 0).SetConcurrencyPolicy(ConcurrencyQueue)
+		now := time.Now()
+		first := start(job, now)
+		queued := make(chan error, 1)
+		go func() {
+			queued <- job.RunAt(ctx, now.Add(time.Second))
+		}()
+		for !job.isBusy() {
+			time.Sleep(time.Millisecond)
+		}
+		if err := job.RunAt(ctx, now.Add(time.Second*2)); err != nil || job.GetRuns() != 2 {
+			t.Fatal("only one run must be queued", job.GetRuns())
+		}
+		close(release)
+		if <-first != nil || <-queued != nil {
+			t.Fatal("runs must succeed")
+		}
+		if calls.Load() != 2 || maxConcurrent.Load() != 1 {
+			t.Fatal("queued run must start after previous run", calls.Load(), maxConcurrent.Load())
+		}
+	})
+}
+
+func TestGroup_ConcurrencyPolicy(t *testing.T) {
+	var concurrent, maxConcurrent, calls atomic.Int32
+	g := NewGroup(time.Millisecond*10, GroupModeAllParallel)
+	g.AddJob(NewJob("test.concurrency", func(ctx context.Context, args ...any) error {
+		calls.Add(1)
+		if n := concurrent.Add(1); n > maxConcurrent.Load() {
+			maxConcurrent.Store(n)
+		}
+		defer concurrent.Add(-1)
+		time.Sleep(time.Millisecond * 35)
+		return nil
+	}, time.Millisecond).SetConcurrencyPolicy(ConcurrencyForbid))
+	ctx, cancel := context.WithTimeout(context.WithValue(context.Background(), "logger", &testLogger{}), time.Millisecond*150)
+	defer cancel()
+	g.Schedule(ctx)
+	if maxConcurrent.Load() != 1 || calls.Load() < 2 {
+		t.Fatal("runs must not overlap", maxConcurrent.Load(), calls.Load())
+	}
+}
+
+func TestJob_AcquireReserve(t *testing.T) {
+	ctx := context.Background()
+	for _, policy := range []ConcurrencyPolicy{ConcurrencyForbid, ConcurrencyQueue} {
+		var calls atomic.Int32
+		held := make(chan struct{}, 1)
+		release := make(chan struct{})
+		job := NewJob("test.acquire", func(ctx context.Context, args ...any) error {
+			calls.Add(1)
+			return nil
+		}, 0).SetConcurrencyPolicy(policy).SetArgsProvider(func(ctx context.Context, t time.Time) []any {
+			select {
+			case held <- struct{}{}:
+				// first run is held between acquire and start
+				<-release
+			default:
+			}
+			return nil
+		})
+		now := time.Now()
+		first := make(chan error, 1)
+		go func() {
+			first <- job.RunAt(ctx, now)
+		}()
+		for len(held) == 0 {
+			time.Sleep(time.Millisecond)
+		}
+		if !job.IsRunning() {
+			t.Fatal("run must be reserved before start", policy)
+		}
+		second := make(chan error, 1)
+		go func() {
+			second <- job.RunAt(ctx, now.Add(time.Second))
+		}()
+		if policy == ConcurrencyForbid {
+			if err := <-second; err != nil || job.GetRuns() != 1 {
+				t.Fatal("second run must be skipped", job.GetRuns())
+			}
+		} else {
+			for !job.isBusy() {
+				time.Sleep(time.Millisecond)
+			}
+		}
+		if calls.Load() != 0 {
+			t.Fatal("callback must not start before release")
+		}
+		close(release)
+		if <-first != nil || policy == ConcurrencyQueue && <-second != nil {
+			t.Fatal("runs must succeed")
+		}
+		if calls.Load() != int32(job.GetRuns()) || job.IsRunning() {
+			t.Fatal("wrong runs", policy, calls.Load(), job.GetRuns())
+		}
+	}
+}
@@ -1,5 +1,11 @@
 package gojob
 
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
 // Operator Type of operator
 type Operator string
 
@@ -8,13 +14,34 @@ const (
 	OperatorAND Operator = "AND"
 	// OperatorOR OR operator
 	OperatorOR Operator = "OR"
+	// OperatorXOR XOR operator. True when odd number of operands is true
+	OperatorXOR Operator = "XOR"
+	// OperatorNOT NOT operator. True when operands joined with AND are false
+	OperatorNOT Operator = "NOT"
 )
 
+// Expression condition expression
 type Expression func() bool
 
+// ContextExpression condition expression with scheduler context and time of check
+// Error means that result of expression is unknown
+type ContextExpression func(ctx context.Context, t time.Time) (bool, error)
+
 // Conditions List of condition
 type Conditions []Condition
 
+// contextOperand named context expression
+type contextOperand struct {
+	// name of expression. empty name for anonymous expression
+	name string
+	// expression with context
+	expression ContextExpression
+	// name of registered predicate. empty if expression is not a predicate
+	predicate string
+	// schedule of time of check. nil if expression is not a schedule
+	schedule *TimePart
+}
+
 // Condition job execute condition
 type Condition struct {
 	// Condition operator
@@ -23,18 +50,79 @@ type Condition struct {
 	mor Operator
 	// list of condition expression
 	expressions []Expression
+	// names of expressions. empty name for anonymous expression
+	names []string
+	// list of context expression. Checked after expressions
+	contextExpressions []contextOperand
 	// list of condition
 	conditions Conditions
+	// max duration of condition check. 0 - unlimited
+	timeout time.Duration
+	// result of condition when check is timed out
+	timeoutPolicy TimeoutPolicy
+	// operands are checked concurrently
+	parallel bool
 }
 
 // IsEmpty check if condition is empty
 func (c Condition) IsEmpty() bool {
-	return c.op == "" && c.mor == "" && len(c.expressions) == 0 && len(c.conditions) == 0
+	return c.op == "" && c.mor == "" && len(c.expressions) == 0 && len(c.contextExpressions) == 0 && len(c.conditions) == 0
 }
 
 // AddExpression add new expressions
 func (c Condition) AddExpression(expression ...Expression) Condition {
 	c.expressions = append(c.expressions, expression...)
+	if len(c.names) > 0 {
+		c.names = append(c.names, make([]string, len(expression))...)
+	}
+	return c
+}
+
+// AddNamedExpression add new expression with name
+func (c Condition) AddNamedExpression(name string, expression Expression) Condition {
+	c.names = append(c.names, make([]string, len(c.expressions)-len(c.names))...)
+	c.names = append(c.names, name)
+	c.expressions = append(c.expressions, expression)
+	return c
+}
+
+// AddContextExpression add new expressions with context
+// Context expressions are checked after expressions
+func (c Condition) AddContextExpression(expression ...ContextExpression) Condition {
+	for i := range expression {
+		c.contextExpressions = append(c.contextExpressions, contextOperand{expression: expression[i]})
+	}
+	return c
+}
+
+// AddNamedContextExpression add new expression with context and name
+func (c Condition) AddNamedContextExpression(name string, expression ContextExpression) Condition {
+	return c.addNamedOperand(contextOperand{name: name, expression: expression})
+}
+
+// AddSchedule add expression which is true when time of check matches the schedule
+func (c Condition) AddSchedule(tp TimePart) Condition {
+	tp = tp.clone()
+	return c.addNamedOperand(contextOperand{
+		name: "schedule " + string(tp.Expression()),
+		expression: func(ctx context.Context, t time.Time) (bool, error) {
+			return tp.Match(t), nil
+		},
+		schedule: &tp,
+	})
+}
+
+// addNamedOperand add context operand. Error of expression is prefixed with name
+func (c Condition) addNamedOperand(o contextOperand) Condition {
+	name, expression := o.name, o.expression
+	o.expression = func(ctx context.Context, t time.Time) (bool, error) {
+		ok, err := expression(ctx, t)
+		if err != nil {
+			err = fmt.Errorf("%s: %w", name, err)
+		}
+		return ok, err
+	}
+	c.contextExpressions = append(c.contextExpressions, o)
 	return c
 }
 
@@ -47,10 +135,12 @@ func (c Condition) SetOperator(operator Operator) Condition {
 // SetExpression merge with condition
 func (c Condition) SetExpression(expression ...Expression) Condition {
 	c.expressions = expression
+	c.names = nil
 	return c
 }
 
 // Merge merge with condition
+// Result of expressions is joined with merged conditions using mor operator
 func (c Condition) Merge(mor Operator, condition ...Condition) Condition {
 	c.mor = mor
 	c.conditions = condition
@@ -58,38 +148,147 @@ func (c Condition) Merge(mor Operator, condition ...Condition) Condition {
 }
 
 // IsTrue check is condition is true
+// Condition with error is false. Use Evaluate to get the error
 func (c Condition) IsTrue() bool {
-	var isTrue bool
-	switch c.op {
+	ok, err := c.Evaluate(context.Background(), time.Now())
+	return ok && err == nil
+}
+
+// Evaluate check condition at t with context
+// Evaluation stops on first error of context expression
+func (c Condition) Evaluate(ctx context.Context, t time.Time) (bool, error) {
+	if c.timeout > 0 {
+		ok, _, err := c.evaluateTimeout(ctx, t)
+		return ok, err
+	}
+	return c.evaluateOperator(ctx, t)
+}
+
+// evaluateOperator check condition according to operator
+func (c Condition) evaluateOperator(ctx context.Context, t time.Time) (bool, error) {
+	if c.op == OperatorNOT {
+		ok, err := c.evaluate(ctx, t, OperatorAND, OperatorAND)
+		return !ok, err
+	}
+	return c.evaluate(ctx, t, c.op, c.mor)
+}
+
+// evaluate expressions joined with op and join the result with merged conditions using mor
+func (c Condition) evaluate(ctx context.Context, t time.Time, op, mor Operator) (bool, error) {
+	if c.parallel {
+		return c.evaluateParallel(ctx, t, op, mor)
+	}
+	if len(c.conditions) == 0 {
+		return c.joinExpressions(ctx, t, op)
+	}
+	if mor == "" {
+		mor = op
+	}
+	if len(c.expressions) == 0 && len(c.contextExpressions) == 0 {
+		return join(mor, len(c.conditions), func(i int) (bool, error) {
+			return c.conditions[i].Evaluate(ctx, t)
+		})
+	}
+	return join(mor, len(c.conditions)+1, func(i int) (bool, error) {
+		if i == 0 {
+			return c.joinExpressions(ctx, t, op)
+		}
+		return c.conditions[i-1].Evaluate(ctx, t)
+	})
+}
+
+// join operands with operator. Operands are evaluated only until result is known or error
+func join(op Operator, n int, operand func(i int) (bool, error)) (bool, error) {
+	switch op {
+	case OperatorAND:
+		for i := 0; i < n; i++ {
+			if ok, err := operand(i); err != nil || !ok {
+				return false, err
+			}
+		}
+		return true, nil
+	case OperatorOR:
+		for i := 0; i < n; i++ {
+			if ok, err := operand(i); err != nil || ok {
+				return ok && err == nil, err
+			}
+		}
+	case OperatorXOR:
+		var isTrue bool
+		for i := 0; i < n; i++ {
+			ok, err := operand(i)
+			if err != nil {
+				return false, err
+			}
+			isTrue = isTrue != ok
+		}
+		return isTrue, nil
+	}
+	return false, nil
+}
+
+// joinExpressions join expressions and context expressions with operator
+// Expressions are evaluated only until result is known or error
+func (c Condition) joinExpressions(ctx context.Context, t time.Time, op Operator) (bool, error) {
+	switch op {
 	case OperatorAND:
 		for i := range c.expressions {
 			if !c.expressions[i]() {
-				return false
+				return false, nil
 			}
 		}
-		if c.mor == OperatorAND {
-			for i := range c.conditions {
-				if !c.conditions[i].IsTrue() {
-					return false
-				}
+		for i := range c.contextExpressions {
+			if ok, err := c.contextExpressions[i].expression(ctx, t); err != nil || !ok {
+				return false, err
 			}
 		}
-		isTrue = true
+		return true, nil
 	case OperatorOR:
 		for i := range c.expressions {
 			if c.expressions[i]() {
-				return true
+				return true, nil
+			}
+		}
+		for i := range c.contextExpressions {
+			if ok, err := c.contextExpressions[i].expression(ctx, t); err != nil || ok {
+				return ok && err == nil, err
 			}
 		}
-		if c.mor == OperatorOR {
-			for i := range c.conditions {
-				if c.conditions[i].IsTrue() {
-					return true
-				}
+	case OperatorXOR:
+		var isTrue bool
+		for i := range c.expressions {
+			isTrue = isTrue != c.expressions[i]()
+		}
+		for i := range c.contextExpressions {
+			ok, err := c.contextExpressions[i].expression(ctx, t)
+			if err != nil {
+				return false, err
 			}
+			isTrue = isTrue != ok
 		}
+		return isTrue, nil
 	}
-	return isTrue
+	return false, nil
+}
+
+// And condition is true when all conditions are true
+func And(condition ...Condition) Condition {
+	return Condition{op: OperatorAND, mor: OperatorAND, conditions: condition}
+}
+
+// Or condition is true when at least one condition is true
+func Or(condition ...Condition) Condition {
+	return Condition{op: OperatorOR, mor: OperatorOR, conditions: condition}
+}
+
+// Xor condition is true when odd number of conditions is true
+func Xor(condition ...Condition) Condition {
+	return Condition{op: OperatorXOR, mor: OperatorXOR, conditions: condition}
+}
+
+// Not condition is true when the condition is false
+func Not(condition Condition) Condition {
+	return Condition{op: OperatorNOT, mor: OperatorAND, conditions: Conditions{condition}}
 }
 
 // NewCondition Init condition
@@ -99,3 +298,8 @@ func NewCondition(op Operator, expression ...Expression) Condition {
 		expressions: expression,
 	}
 }
+
+// NewContextCondition Init condition with context expressions
+func NewContextCondition(op Operator, expression ...ContextExpression) Condition {
+	return Condition{op: op}.AddContextExpression(expression...)
+}
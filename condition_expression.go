@@ -0,0 +1,185 @@
+package gojob
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ConditionExpression condition defined with registered predicate names
+// AND, OR, XOR, NOT - operators (case insensitive), && || ! are also supported
+// ( ) - nesting
+// Operator precedence from high to low: NOT, AND, XOR, OR
+// Example: weekday AND (isPrimaryRegion OR NOT holiday)
+type ConditionExpression string
+
+// conditionToken lexical token of condition expression
+type conditionToken struct {
+	// token text
+	value string
+	// position in expression
+	pos int
+}
+
+// conditionParser recursive descent parser of condition expression
+type conditionParser struct {
+	tokens []conditionToken
+	pos    int
+}
+
+// Parse convert expression to Condition
+// All predicates must be registered before parsing
+func (e ConditionExpression) Parse() (Condition, error) {
+	tokens, err := tokenizeCondition(string(e))
+	if err != nil {
+		return Condition{}, err
+	}
+	if len(tokens) == 0 {
+		return Condition{}, errors.New("condition expression is empty")
+	}
+	p := conditionParser{tokens: tokens}
+	cond, err := p.parseOr()
+	if err != nil {
+		return Condition{}, err
+	}
+	if p.pos < len(p.tokens) {
+		return Condition{}, p.unexpected()
+	}
+	return cond, nil
+}
+
+// tokenizeCondition split expression to tokens
+func tokenizeCondition(expression string) ([]conditionToken, error) {
+	var tokens []conditionToken
+	var i int
+	for i < len(expression) {
+		ch := expression[i]
+		switch {
+		case ch == ' ' || ch == '\t' || ch == '\n' || ch == '\r':
+			i++
+		case ch == '(' || ch == ')' || ch == '!':
+			tokens = append(tokens, conditionToken{value: expression[i : i+1], pos: i})
+			i++
+		case ch == '&' || ch == '|':
+			if i+1 >= len(expression) || expression[i+1] != ch {
+				return nil, fmt.Errorf("condition expression: unexpected symbol '%c' at position %v", ch, i)
+			}
+			tokens = append(tokens, conditionToken{value: expression[i : i+2], pos: i})
+			i += 2
+		case isPredicateSymbol(ch):
+			j := i
+			for j < len(expression) && isPredicateSymbol(expression[j]) {
+				j++
+			}
+			tokens = append(tokens, conditionToken{value: expression[i:j], pos: i})
+			i = j
+		default:
+			return nil, fmt.Errorf("condition expression: unexpected symbol '%c' at position %v", ch, i)
+		}
+	}
+	return tokens, nil
+}
+
+// isPredicateSymbol check if symbol is allowed in predicate name
+func isPredicateSymbol(ch byte) bool {
+	return ch == '_' || ch == '.' || ch == '-' || ch == ':' ||
+		('a' <= ch && ch <= 'z') || ('A' <= ch && ch <= 'Z') || ('0' <= ch && ch <= '9')
+}
+
+// peek operator of current token
+func (p *conditionParser) peek() Operator {
+	if p.pos >= len(p.tokens) {
+		return ""
+	}
+	switch strings.ToUpper(p.tokens[p.pos].value) {
+	case "AND", "&&":
+		return OperatorAND
+	case "OR", "||":
+		return OperatorOR
+	case "XOR":
+		return OperatorXOR
+	case "NOT", "!":
+		return OperatorNOT
+	}
+	return ""
+}
+
+// unexpected error for current token
+func (p *conditionParser) unexpected() error {
+	if p.pos >= len(p.tokens) {
+		return errors.New("condition expression: unexpected end of expression")
+	}
+	return fmt.Errorf("condition expression: unexpected '%s' at position %v", p.tokens[p.pos].value, p.tokens[p.pos].pos)
+}
+
+// parseOr or := xor (OR xor)*
+func (p *conditionParser) parseOr() (Condition, error) {
+	return p.parseBinary(OperatorOR, p.parseXor)
+}
+
+// parseXor xor := and (XOR and)*
+func (p *conditionParser) parseXor() (Condition, error) {
+	return p.parseBinary(OperatorXOR, p.parseAnd)
+}
+
+// parseAnd and := unary (AND unary)*
+func (p *conditionParser) parseAnd() (Condition, error) {
+	return p.parseBinary(OperatorAND, p.parseUnary)
+}
+
+// parseBinary chain of operands joined with operator
+func (p *conditionParser) parseBinary(op Operator, operand func() (Condition, error)) (Condition, error) {
+	cond, err := operand()
+	if err != nil {
+		return Condition{}, err
+	}
+	operands := Conditions{cond}
+	for p.peek() == op {
+		p.pos++
+		cond, err = operand()
+		if err != nil {
+			return Condition{}, err
+		}
+		operands = append(operands, cond)
+	}
+	if len(operands) == 1 {
+		return operands[0], nil
+	}
+	return Condition{op: op, mor: op, conditions: operands}, nil
+}
+
+// parseUnary unary := NOT unary | ( or ) | predicate
+func (p *conditionParser) parseUnary() (Condition, error) {
+	if p.pos >= len(p.tokens) {
+		return Condition{}, p.unexpected()
+	}
+	if p.peek() == OperatorNOT {
+		p.pos++
+		cond, err := p.parseUnary()
+		if err != nil {
+			return Condition{}, err
+		}
+		return Not(cond), nil
+	}
+	token := p.tokens[p.pos]
+	switch {
+	case token.value == "(":
+		p.pos++
+		cond, err := p.parseOr()
+		if err != nil {
+			return Condition{}, err
+		}
+		if p.pos >= len(p.tokens) || p.tokens[p.pos].value != ")" {
+			return Condition{}, p.unexpected()
+		}
+		p.pos++
+		return cond, nil
+	case p.peek() != "" || !isPredicateSymbol(token.value[0]):
+		return Condition{}, p.unexpected()
+	}
+	if !IsPredicateRegistered(token.value) {
+		return Condition{}, fmt.Errorf("condition expression: predicate '%s' at position %v is not registered", token.value, token.pos)
+	}
+	p.pos++
+	return UsePredicate(token.value), nil
+}
@@ -0,0 +1,69 @@
+package gojob
+
+import (
+	"testing"
+)
+
+func TestConditionExpression_Parse(t *testing.T) {
+	values := map[string]bool{
+		"weekday":         true,
+		"isPrimaryRegion": false,
+		"holiday":         true,
+		"region.eu-1":     true,
+	}
+	for name := range values {
+		RegisterPredicate(name, func() bool {
+			return values[name]
+		})
+	}
+	defer func() {
+		for name := range values {
+			UnregisterPredicate(name)
+		}
+	}()
+	cases := []struct {
+		expression ConditionExpression
+		result     bool
+	}{
+		{"weekday", true},
+		{"NOT weekday", false},
+		{"weekday AND (isPrimaryRegion OR NOT holiday)", false},
+		{"weekday and (isPrimaryRegion or not holiday or region.eu-1)", true},
+		{"weekday && !(isPrimaryRegion || holiday)", false},
+		{"isPrimaryRegion OR holiday AND weekday", true},
+		{"isPrimaryRegion AND holiday OR weekday", true},
+		{"weekday XOR holiday", false},
+		{"weekday XOR holiday XOR region.eu-1", true},
+		{"NOT NOT weekday", true},
+		{"((weekday))", true},
+	}
+	for _, c := range cases {
+		t.Run(string(c.expression), func(t *testing.T) {
+			cond, err := c.expression.Parse()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if cond.IsTrue() != c.result {
+				t.Fatalf("must be %v", c.result)
+			}
+		})
+	}
+	errs := []ConditionExpression{
+		"",
+		"weekday AND",
+		"(weekday",
+		"weekday)",
+		"weekday & holiday",
+		"weekday holiday",
+		"unknown",
+		"weekday OR $",
+		"AND weekday",
+	}
+	for _, e := range errs {
+		t.Run("error "+string(e), func(t *testing.T) {
+			if _, err := e.Parse(); err == nil {
+				t.Fatal("must be an error")
+			}
+		})
+	}
+}
@@ -0,0 +1,151 @@
+package gojob
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// ErrConditionNotSerializable condition contains expression which is not a registered predicate or schedule
+var ErrConditionNotSerializable = errors.New("condition is not serializable")
+
+const (
+	// timeoutSkipName serialized TimeoutSkip
+	timeoutSkipName = "skip"
+	// timeoutRunName serialized TimeoutRun
+	timeoutRunName = "run"
+)
+
+// conditionConfig serializable condition
+type conditionConfig struct {
+	// Condition operator
+	Operator Operator `yaml:"operator,omitempty" json:"operator,omitempty"`
+	// Merge operator
+	Merge Operator `yaml:"merge,omitempty" json:"merge,omitempty"`
+	// Names of registered predicates
+	Predicates []string `yaml:"predicates,omitempty" json:"predicates,omitempty"`
+	// Schedule of time of check
+	Schedule ScheduleExpression `yaml:"schedule,omitempty" json:"schedule,omitempty"`
+	// Max duration of condition check, e.g. 1s
+	Timeout string `yaml:"timeout,omitempty" json:"timeout,omitempty"`
+	// Result of timed out condition: skip or run
+	TimeoutPolicy string `yaml:"timeoutPolicy,omitempty" json:"timeoutPolicy,omitempty"`
+	// Operands are checked concurrently
+	Parallel bool `yaml:"parallel,omitempty" json:"parallel,omitempty"`
+	// Merged conditions
+	Conditions Conditions `yaml:"conditions,omitempty" json:"conditions,omitempty"`
+}
+
+// config serializable condition
+func (c Condition) config() (conditionConfig, error) {
+	config := conditionConfig{
+		Operator:   c.op,
+		Merge:      c.mor,
+		Parallel:   c.parallel,
+		Conditions: c.conditions,
+	}
+	if len(c.expressions) > 0 {
+		name := "#1"
+		if len(c.names) > 0 && c.names[0] != "" {
+			name = c.names[0]
+		}
+		return config, fmt.Errorf("%w: expression %s", ErrConditionNotSerializable, name)
+	}
+	for _, o := range c.contextExpressions {
+		switch {
+		case o.predicate != "":
+			config.Predicates = append(config.Predicates, o.predicate)
+		case o.schedule != nil && config.Schedule == "":
+			config.Schedule = o.schedule.Expression()
+		default:
+			return config, fmt.Errorf("%w: expression %s", ErrConditionNotSerializable, o.name)
+		}
+	}
+	if c.timeout > 0 {
+		config.Timeout = c.timeout.String()
+		config.TimeoutPolicy = timeoutSkipName
+		if c.timeoutPolicy == TimeoutRun {
+			config.TimeoutPolicy = timeoutRunName
+		}
+	}
+	return config, nil
+}
+
+// condition create condition from config
+// Predicates must be registered
+func (config conditionConfig) condition() (Condition, error) {
+	c := Condition{
+		op:         config.Operator,
+		mor:        config.Merge,
+		parallel:   config.Parallel,
+		conditions: config.Conditions,
+	}
+	for _, name := range config.Predicates {
+		if !IsPredicateRegistered(name) {
+			return c, fmt.Errorf("%w: %s", ErrPredicateNotRegistered, name)
+		}
+		c.contextExpressions = append(c.contextExpressions, UsePredicate(name).contextExpressions...)
+	}
+	if config.Schedule != "" {
+		tp, err := config.Schedule.Parse()
+		if err != nil {
+			return c, err
+		}
+		c = c.AddSchedule(tp)
+	}
+	if config.Timeout != "" {
+		d, err := time.ParseDuration(config.Timeout)
+		if err != nil {
+			return c, err
+		}
+		c.timeout = d
+	}
+	switch config.TimeoutPolicy {
+	case "", timeoutSkipName:
+		c.timeoutPolicy = TimeoutSkip
+	case timeoutRunName:
+		c.timeoutPolicy = TimeoutRun
+	default:
+		return c, fmt.Errorf("unknown timeout policy: %s", config.TimeoutPolicy)
+	}
+	return c, nil
+}
+
+// MarshalJSON serialize condition with names of registered predicates and schedule expression
+// Returns ErrConditionNotSerializable if condition contains other expressions
+func (c Condition) MarshalJSON() ([]byte, error) {
+	config, err := c.config()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(config)
+}
+
+// UnmarshalJSON deserialize condition. Predicates must be registered
+func (c *Condition) UnmarshalJSON(data []byte) error {
+	var config conditionConfig
+	err := json.Unmarshal(data, &config)
+	if err != nil {
+		return err
+	}
+	*c, err = config.condition()
+	return err
+}
+
+// MarshalYAML serialize condition with names of registered predicates and schedule expression
+// Returns ErrConditionNotSerializable if condition contains other expressions
+func (c Condition) MarshalYAML() (interface{}, error) {
+	return c.config()
+}
+
+// UnmarshalYAML deserialize condition. Predicates must be registered
+func (c *Condition) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var config conditionConfig
+	err := unmarshal(&config)
+	if err != nil {
+		return err
+	}
+	*c, err = config.condition()
+	return err
+}
@@ -0,0 +1,160 @@
+package gojob
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+func TestCondition_MarshalJSON(t *testing.T) {
+	var primary, holiday atomic.Bool
+	RegisterPredicate("test.marshal.primary", primary.Load)
+	RegisterPredicate("test.marshal.holiday", holiday.Load)
+	defer UnregisterPredicate("test.marshal.primary")
+	defer UnregisterPredicate("test.marshal.holiday")
+	businessHours := mustParse(t, "- - - 9-17 1-5 - - - -")
+	cond := Or(
+		UsePredicate("test.marshal.primary"),
+		And(NewCondition(OperatorAND).AddSchedule(businessHours), Not(UsePredicate("test.marshal.holiday"))),
+	).SetTimeout(time.Second, TimeoutRun).SetParallel(true)
+	data, err := json.Marshal(cond)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var restored Condition
+	if err = json.Unmarshal(data, &restored); err != nil {
+		t.Fatal(err)
+	}
+	again, err := json.Marshal(restored)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(again) != string(data) {
+		t.Fatal("round trip must be equal", string(data), string(again))
+	}
+	monday10 := time.Date(2026, 1, 5, 10, 0, 0, 0, time.UTC)
+	sunday10 := time.Date(2026, 1, 4, 10, 0, 0, 0, time.UTC)
+	for _, c := range []struct {
+		primary, holiday bool
+		at               time.Time
+	}{
+		{false, false, monday10}, {false, true, monday10}, {false, false, sunday10}, {true, true, sunday10},
+	} {
+		primary.Store(c.primary)
+		holiday.Store(c.holiday)
+		expected, _ := cond.Evaluate(context.Background(), c.at)
+		result, err := restored.Evaluate(context.Background(), c.at)
+		if err != nil || result != expected {
+			t.Fatal("restored condition must have the same result", c, err)
+		}
+	}
+	t.Run("expression", func(t *testing.T) {
+		parsed, err := ConditionExpression("test.marshal.primary AND NOT test.marshal.holiday").Parse()
+		if err != nil {
+			t.Fatal(err)
+		}
+		data, err := json.Marshal(parsed)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(data) != `{"operator":"AND","merge":"AND","conditions":[{"operator":"AND","predicates":["test.marshal.primary"]},{"operator":"NOT","merge":"AND","conditions":[{"operator":"AND","predicates":["test.marshal.holiday"]}]}]}` {
+			t.Fatal("wrong json", string(data))
+		}
+	})
+	t.Run("not_serializable", func(t *testing.T) {
+		_, err := json.Marshal(And(UsePredicate("test.marshal.primary"), NewCondition(OperatorAND).AddNamedExpression("closure", func() bool { return true })))
+		if !errors.Is(err, ErrConditionNotSerializable) {
+			t.Fatal("must be not serializable error", err)
+		}
+	})
+	t.Run("not_registered", func(t *testing.T) {
+		var c Condition
+		err := json.Unmarshal([]byte(`{"operator":"AND","predicates":["test.marshal.unknown"]}`), &c)
+		if !errors.Is(err, ErrPredicateNotRegistered) {
+			t.Fatal("must be not registered error", err)
+		}
+		if err = json.Unmarshal([]byte(`{"operator":"AND","schedule":"- - -"}`), &c); err == nil {
+			t.Fatal("must be schedule error")
+		}
+	})
+}
+
+func TestCondition_MarshalYAML(t *testing.T) {
+	var primary atomic.Bool
+	RegisterPredicate("test.marshal.yaml", primary.Load)
+	defer UnregisterPredicate("test.marshal.yaml")
+	cond := Or(
+		Not(UsePredicate("test.marshal.yaml")).SetTimeout(time.Millisecond*500, TimeoutSkip),
+		NewCondition(OperatorAND).AddSchedule(mustParse(t, "- - - 9-17 1-5 - - - -")),
+	).SetParallel(true)
+	data, err := yaml.Marshal(cond)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := "operator: OR\n" +
+		"merge: OR\n" +
+		"parallel: true\n" +
+		"conditions:\n" +
+		"    - operator: NOT\n" +
+		"      merge: AND\n" +
+		"      timeout: 500ms\n" +
+		"      timeoutPolicy: skip\n" +
+		"      conditions:\n" +
+		"        - operator: AND\n" +
+		"          predicates:\n" +
+		"            - test.marshal.yaml\n" +
+		"    - operator: AND\n" +
+		"      schedule: '- - - 9-17 1-5 - - - -'\n"
+	if string(data) != expected {
+		t.Fatal("wrong yaml\n" + string(data))
+	}
+	var restored Condition
+	if err = yaml.Unmarshal(data, &restored); err != nil {
+		t.Fatal(err)
+	}
+	again, err := yaml.Marshal(restored)
+	if err != nil || string(again) != string(data) {
+		t.Fatal("round trip must be equal", err, string(again))
+	}
+	if restored.conditions[0].timeout != time.Millisecond*500 || restored.conditions[0].timeoutPolicy != TimeoutSkip {
+		t.Fatal("wrong restored timeout")
+	}
+	monday10 := time.Date(2026, 1, 5, 10, 0, 0, 0, time.UTC)
+	sunday10 := time.Date(2026, 1, 4, 10, 0, 0, 0, time.UTC)
+	for _, at := range []time.Time{monday10, sunday10} {
+		for _, p := range []bool{false, true} {
+			primary.Store(p)
+			expected, _ := cond.Evaluate(context.Background(), at)
+			if result, err := restored.Evaluate(context.Background(), at); err != nil || result != expected {
+				t.Fatal("restored condition must have the same result", at, p, err)
+			}
+		}
+	}
+	if _, err = yaml.Marshal(NewCondition(OperatorAND, func() bool { return true })); !errors.Is(err, ErrConditionNotSerializable) {
+		t.Fatal("must be not serializable error", err)
+	}
+}
+
+func TestTimePart_ToConditionMarshal(t *testing.T) {
+	cond := mustParse(t, "- - - 9-17 1-5 - - - -").ToCondition()
+	data, err := json.Marshal(cond)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != `{"operator":"AND","schedule":"- - - 9-17 1-5 - - - -"}` {
+		t.Fatal("wrong json", string(data))
+	}
+	var restored Condition
+	if err = json.Unmarshal(data, &restored); err != nil {
+		t.Fatal(err)
+	}
+	monday10 := time.Date(2026, 1, 5, 10, 0, 0, 0, time.UTC)
+	if ok, err := restored.Evaluate(context.Background(), monday10); !ok || err != nil {
+		t.Fatal("restored condition must match the schedule", err)
+	}
+}
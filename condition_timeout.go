@@ -0,0 +1,149 @@
+package gojob
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// ErrConditionTimeout condition check is timed out
+var ErrConditionTimeout = errors.New("condition timeout")
+
+// TimeoutPolicy result of condition when check is timed out
+type TimeoutPolicy uint8
+
+const (
+	// TimeoutSkip timed out condition is false. Job is skipped
+	TimeoutSkip TimeoutPolicy = iota
+	// TimeoutRun timed out condition is true. Job is started
+	TimeoutRun
+)
+
+// conditionResult result of condition check
+type conditionResult struct {
+	ok  bool
+	err error
+}
+
+// conditionOperand operand of parallel condition check
+type conditionOperand func(ctx context.Context) (bool, error)
+
+// SetTimeout set max duration of condition check and result of timed out condition
+// Context of context expressions is cancelled after timeout
+// Expressions are not interrupted, their result is ignored after timeout
+func (c Condition) SetTimeout(d time.Duration, policy TimeoutPolicy) Condition {
+	c.timeout = d
+	c.timeoutPolicy = policy
+	return c
+}
+
+// SetParallel check expressions, context expressions and merged conditions concurrently
+// Result is returned as soon as it is known, context of the rest checks is cancelled
+func (c Condition) SetParallel(parallel bool) Condition {
+	c.parallel = parallel
+	return c
+}
+
+// evaluateTimeout check condition with timeout
+// timedOut is true when result is defined by timeout policy
+func (c Condition) evaluateTimeout(ctx context.Context, t time.Time) (ok bool, timedOut bool, err error) {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+	result := make(chan conditionResult, 1)
+	go func() {
+		ok, err := c.evaluateOperator(ctx, t)
+		result <- conditionResult{ok: ok, err: err}
+	}()
+	select {
+	case r := <-result:
+		if r.err == nil || !errors.Is(r.err, context.DeadlineExceeded) || ctx.Err() == nil {
+			return r.ok, false, r.err
+		}
+	case <-ctx.Done():
+	}
+	if errors.Is(ctx.Err(), context.Canceled) {
+		return false, false, ctx.Err()
+	}
+	return c.timeoutPolicy == TimeoutRun, true, nil
+}
+
+// evaluateParallel check operands concurrently
+// expressions are joined with op and the result is joined with merged conditions using mor
+func (c Condition) evaluateParallel(ctx context.Context, t time.Time, op, mor Operator) (bool, error) {
+	expressions := make([]conditionOperand, 0, len(c.expressions)+len(c.contextExpressions))
+	for i := range c.expressions {
+		expression := c.expressions[i]
+		expressions = append(expressions, func(ctx context.Context) (bool, error) {
+			return expression(), nil
+		})
+	}
+	for i := range c.contextExpressions {
+		expression := c.contextExpressions[i].expression
+		expressions = append(expressions, func(ctx context.Context) (bool, error) {
+			return expression(ctx, t)
+		})
+	}
+	if len(c.conditions) == 0 {
+		return joinParallel(ctx, op, expressions)
+	}
+	if mor == "" {
+		mor = op
+	}
+	conditions := make([]conditionOperand, 0, len(c.conditions)+1)
+	switch {
+	case len(expressions) == 0:
+	case op == mor:
+		conditions = append(conditions, expressions...)
+	default:
+		conditions = append(conditions, func(ctx context.Context) (bool, error) {
+			return joinParallel(ctx, op, expressions)
+		})
+	}
+	for i := range c.conditions {
+		condition := c.conditions[i]
+		conditions = append(conditions, func(ctx context.Context) (bool, error) {
+			return condition.Evaluate(ctx, t)
+		})
+	}
+	return joinParallel(ctx, mor, conditions)
+}
+
+// joinParallel join operands checked concurrently with operator
+// Result is returned on first error or as soon as it is known
+func joinParallel(ctx context.Context, op Operator, operands []conditionOperand) (bool, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	results := make(chan conditionResult, len(operands))
+	for i := range operands {
+		go func(operand conditionOperand) {
+			ok, err := operand(ctx)
+			results <- conditionResult{ok: ok, err: err}
+		}(operands[i])
+	}
+	var isTrue bool
+	for range operands {
+		r := <-results
+		if r.err != nil {
+			return false, r.err
+		}
+		switch op {
+		case OperatorAND:
+			if !r.ok {
+				return false, nil
+			}
+		case OperatorOR:
+			if r.ok {
+				return true, nil
+			}
+		case OperatorXOR:
+			isTrue = isTrue != r.ok
+		}
+	}
+	switch op {
+	case OperatorAND:
+		return true, nil
+	case OperatorXOR:
+		return isTrue, nil
+	}
+	return false, nil
+}
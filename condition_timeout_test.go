@@ -0,0 +1,104 @@
+package gojob
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestCondition_SetTimeout(t *testing.T) {
+	slow := func() bool {
+		time.Sleep(time.Millisecond * 200)
+		return true
+	}
+	t.Run("skip", func(t *testing.T) {
+		start := time.Now()
+		ok, err := NewCondition(OperatorAND, slow).SetTimeout(time.Millisecond*20, TimeoutSkip).Evaluate(context.Background(), start)
+		if ok || err != nil {
+			t.Fatal("timed out condition must be false", err)
+		}
+		if time.Since(start) > time.Millisecond*150 {
+			t.Fatal("check must not wait for expression")
+		}
+	})
+	t.Run("run", func(t *testing.T) {
+		cond := NewContextCondition(OperatorAND, func(ctx context.Context, t time.Time) (bool, error) {
+			<-ctx.Done()
+			return false, ctx.Err()
+		}).SetTimeout(time.Millisecond*20, TimeoutRun)
+		ok, err := cond.Evaluate(context.Background(), time.Now())
+		if !ok || err != nil {
+			t.Fatal("timed out condition must be true", err)
+		}
+		e := cond.Explain(time.Now())
+		if !e.Result || e.Error != ErrConditionTimeout.Error() {
+			t.Fatal("explanation must contain timeout", e)
+		}
+	})
+	t.Run("in_time", func(t *testing.T) {
+		ok, err := NewCondition(OperatorAND, func() bool { return false }).SetTimeout(time.Second, TimeoutRun).Evaluate(context.Background(), time.Now())
+		if ok || err != nil {
+			t.Fatal("result must not be changed", err)
+		}
+	})
+	t.Run("nested", func(t *testing.T) {
+		cond := Or(NewCondition(OperatorAND, slow).SetTimeout(time.Millisecond*20, TimeoutSkip), NewCondition(OperatorAND, func() bool { return true }))
+		if !cond.IsTrue() {
+			t.Fatal("must be true")
+		}
+	})
+}
+
+func TestCondition_SetParallel(t *testing.T) {
+	slow := func() bool {
+		time.Sleep(time.Millisecond * 200)
+		return true
+	}
+	yes := func() bool { return true }
+	no := func() bool { return false }
+	check := func(t *testing.T, cond Condition, result bool) {
+		start := time.Now()
+		ok, err := cond.SetParallel(true).Evaluate(context.Background(), start)
+		if err != nil || ok != result {
+			t.Fatal("wrong result", ok, err)
+		}
+		if time.Since(start) > time.Millisecond*150 {
+			t.Fatal("result must be returned without slow expression")
+		}
+	}
+	t.Run("and", func(t *testing.T) {
+		check(t, NewCondition(OperatorAND, slow, no), false)
+	})
+	t.Run("or", func(t *testing.T) {
+		check(t, NewCondition(OperatorOR, slow, yes), true)
+	})
+	t.Run("merge", func(t *testing.T) {
+		check(t, NewCondition(OperatorAND, slow, no).Merge(OperatorOR, NewCondition(OperatorAND, yes)), true)
+		check(t, Or(NewCondition(OperatorAND, slow), NewCondition(OperatorAND, yes)), true)
+	})
+	t.Run("cancel", func(t *testing.T) {
+		check(t, NewCondition(OperatorAND, no).AddContextExpression(func(ctx context.Context, t time.Time) (bool, error) {
+			<-ctx.Done()
+			return false, ctx.Err()
+		}), false)
+	})
+	t.Run("xor", func(t *testing.T) {
+		ok, _ := NewCondition(OperatorXOR, yes, no, yes).SetParallel(true).Evaluate(context.Background(), time.Now())
+		if ok {
+			t.Fatal("even number of true must be false")
+		}
+		if !Not(NewCondition(OperatorOR, no, no).SetParallel(true)).IsTrue() {
+			t.Fatal("must be true")
+		}
+	})
+	t.Run("error", func(t *testing.T) {
+		errFlag := errors.New("flag")
+		_, err := NewCondition(OperatorOR, slow).AddContextExpression(func(ctx context.Context, t time.Time) (bool, error) {
+			return false, errFlag
+		}).SetParallel(true).Evaluate(context.Background(), time.Now())
+		if !errors.Is(err, errFlag) {
+			t.Fatal("error must be returned", err)
+		}
+	})
+}
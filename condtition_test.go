@@ -1,7 +1,10 @@
 package gojob
 
 import (
+	"context"
+	"errors"
 	"testing"
+	"time"
 )
 
 func TestCondition(t *testing.T) {
@@ -229,3 +232,123 @@ func BenchmarkCondition(b *testing.B) {
 	}
 	b.ReportAllocs()
 }
+
+func TestConditionTree(t *testing.T) {
+	yes := NewCondition(OperatorAND, func() bool {
+		return true
+	})
+	no := NewCondition(OperatorAND, func() bool {
+		return false
+	})
+	t.Run("and_merged_with_or", func(t *testing.T) {
+		// true && (false || true)
+		cond := yes.Merge(OperatorOR, no, yes)
+		if !cond.IsTrue() {
+			t.Fatal("must be true")
+		}
+		// false || (false || false)
+		cond = no.Merge(OperatorOR, no, no)
+		if cond.IsTrue() {
+			t.Fatal("must be false")
+		}
+	})
+	t.Run("merge_without_expressions", func(t *testing.T) {
+		if NewCondition(OperatorAND).Merge(OperatorOR, no, no).IsTrue() {
+			t.Fatal("must be false")
+		}
+		if !NewCondition(OperatorOR).Merge(OperatorAND, yes, yes).IsTrue() {
+			t.Fatal("must be true")
+		}
+	})
+	t.Run("and_or", func(t *testing.T) {
+		if !Or(no, And(yes, yes)).IsTrue() {
+			t.Fatal("must be true")
+		}
+		if And(yes, Or(no, no)).IsTrue() {
+			t.Fatal("must be false")
+		}
+		if !And().IsTrue() || Or().IsTrue() {
+			t.Fatal("empty and must be true, empty or must be false")
+		}
+	})
+	t.Run("not", func(t *testing.T) {
+		if Not(yes).IsTrue() || !Not(no).IsTrue() {
+			t.Fatal("wrong not")
+		}
+		if !Not(And(yes, no)).IsTrue() {
+			t.Fatal("must be true")
+		}
+		if !And(yes, Not(Or(no, no))).IsTrue() {
+			t.Fatal("must be true")
+		}
+	})
+	t.Run("xor", func(t *testing.T) {
+		if !Xor(yes, no).IsTrue() || Xor(yes, yes).IsTrue() || Xor(no, no).IsTrue() {
+			t.Fatal("wrong xor")
+		}
+		if !Xor(yes, yes, yes).IsTrue() {
+			t.Fatal("odd number of true must be true")
+		}
+		if !NewCondition(OperatorXOR, func() bool { return true }, func() bool { return false }).IsTrue() {
+			t.Fatal("xor of expressions must be true")
+		}
+	})
+	t.Run("short_circuit", func(t *testing.T) {
+		var calls int
+		counted := NewCondition(OperatorAND, func() bool {
+			calls++
+			return true
+		})
+		if Or(yes, counted).IsTrue(); calls != 0 {
+			t.Fatal("or must stop on first true")
+		}
+		if And(no, counted).IsTrue(); calls != 0 {
+			t.Fatal("and must stop on first false")
+		}
+	})
+}
+
+func TestCondition_Evaluate(t *testing.T) {
+	errFlag := errors.New("flag service is unavailable")
+	type key struct{}
+	ctx := context.WithValue(context.Background(), key{}, "tenant")
+	now := time.Now()
+	t.Run("context_and_time", func(t *testing.T) {
+		cond := NewContextCondition(OperatorAND, func(c context.Context, tm time.Time) (bool, error) {
+			return c.Value(key{}) == "tenant" && tm.Equal(now), nil
+		})
+		ok, err := cond.Evaluate(ctx, now)
+		if err != nil || !ok {
+			t.Fatal("must be true", err)
+		}
+	})
+	t.Run("error", func(t *testing.T) {
+		cond := NewCondition(OperatorAND, func() bool { return true }).
+			AddNamedContextExpression("flag", func(ctx context.Context, t time.Time) (bool, error) {
+				return true, errFlag
+			})
+		ok, err := cond.Evaluate(ctx, now)
+		if ok || !errors.Is(err, errFlag) || err.Error() != "flag: flag service is unavailable" {
+			t.Fatal("error must be returned", err)
+		}
+		if cond.IsTrue() {
+			t.Fatal("condition with error must be false")
+		}
+		if _, err = Not(cond).Evaluate(ctx, now); !errors.Is(err, errFlag) {
+			t.Fatal("error must be returned from merged condition", err)
+		}
+		e := Not(cond).ExplainContext(ctx, now)
+		if e.Result || e.Children[0].Children[1].Error == "" {
+			t.Fatal("explanation must contain error", e)
+		}
+	})
+	t.Run("short_circuit", func(t *testing.T) {
+		failed := NewContextCondition(OperatorAND, func(ctx context.Context, t time.Time) (bool, error) {
+			return false, errFlag
+		})
+		ok, err := Or(NewCondition(OperatorAND, func() bool { return true }), failed).Evaluate(ctx, now)
+		if !ok || err != nil {
+			t.Fatal("error must not be reached", err)
+		}
+	})
+}
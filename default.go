@@ -16,24 +16,101 @@ func SetRepeatDuration(d time.Duration) {
 	group.SetRepeatDuration(d)
 }
 
+// SetDebug log reasons why jobs of default schedule are skipped
+// Must be called before Run
+func SetDebug(debug bool) {
+	group.SetDebug(debug)
+}
+
 // Add job to default schedule
 func Add(name string, expression ScheduleExpression, callback JobCallback, condition ...Condition) (*Job, error) {
-	job := NewJob(name, callback, group.d)
 	tp, err := expression.Parse()
 	if err != nil {
 		return nil, err
 	}
-	cond := tp.ToCondition()
-	if len(condition) > 0 {
-		cond = cond.Merge(OperatorAND, condition...)
-	}
-	job.SetCondition(cond)
-	job.SetNextTime(time.Now())
+	job := newScheduleJob(name, tp, callback, condition...)
 	job.SetRepeatPeriod(tp.GetRepeatPeriod())
 	group.AddJob(job)
 	return job, nil
 }
 
+// AddSchedule add job with custom schedule to default schedule
+func AddSchedule(name string, schedule Schedule, callback JobCallback, condition ...Condition) *Job {
+	job := newScheduleJob(name, schedule, callback, condition...)
+	group.AddJob(job)
+	return job
+}
+
+// AddOnce add job to default schedule which runs once at time t and then removed
+func AddOnce(job *Job, t time.Time) *Job {
+	group.AddOnce(job, t)
+	return job
+}
+
+// RunOnceAt create job in default schedule which runs once at time t and then removed
+func RunOnceAt(name string, t time.Time, callback JobCallback) *Job {
+	return group.RunOnceAt(name, t, callback)
+}
+
+// AddBlackout add period when jobs of default schedule can't start
+func AddBlackout(expression ScheduleExpression, policy BlackoutPolicy) error {
+	return group.AddBlackout(expression, policy)
+}
+
+// Pause job of default schedule. Paused job is not started until resumed
+func Pause(name string) error {
+	return group.Pause(name)
+}
+
+// Resume paused job of default schedule
+func Resume(name string) error {
+	return group.Resume(name)
+}
+
+// Disable job of default schedule. Disabled job is not started until enabled
+func Disable(name string) error {
+	return group.Disable(name)
+}
+
+// Enable disabled job of default schedule
+func Enable(name string) error {
+	return group.Enable(name)
+}
+
+// JobSucceededWithin condition is true when job of default schedule succeeded within duration before time of check
+func JobSucceededWithin(name string, d time.Duration) Condition {
+	return group.JobSucceededWithin(name, d)
+}
+
+// JobFailed condition is true when last finished run of job of default schedule returned error
+func JobFailed(name string) Condition {
+	return group.JobFailed(name)
+}
+
+// JobRunning condition is true when job of default schedule is running
+func JobRunning(name string) Condition {
+	return group.JobRunning(name)
+}
+
+// Cached condition with named expression which result is shared by jobs of default schedule
+// ttl - result is reused during ttl, 0 - result is computed once per tick
+func Cached(name string, ttl time.Duration, expression ContextExpression) Condition {
+	return group.Cached(name, ttl, expression)
+}
+
+// create job for default schedule
+func newScheduleJob(name string, schedule Schedule, callback JobCallback, condition ...Condition) *Job {
+	job := NewJob(name, callback, group.d)
+	if _, ok := schedule.(AlignedSchedule); !ok {
+		job.SetNextTime(time.Now())
+	}
+	job.SetSchedule(schedule)
+	if len(condition) > 0 {
+		job.SetCondition(NewCondition(OperatorAND).Merge(OperatorAND, condition...))
+	}
+	return job
+}
+
 // Run default schedule group
 func Run(logger Logger, middleware ...Middleware) {
 	defaultCtx.Done()
@@ -46,3 +123,8 @@ func Run(logger Logger, middleware ...Middleware) {
 func initDefaultGroup() *Group {
 	return NewGroup(time.Minute, GroupModeConsistently)
 }
+
+// Lint check expression for unreachable and suspicious combinations with default schedule repeat duration
+func Lint(expression ScheduleExpression) ([]LintWarning, error) {
+	return expression.Lint(group.d)
+}
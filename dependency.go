@@ -0,0 +1,40 @@
+package gojob
+
+import (
+	"context"
+	"time"
+)
+
+// JobSucceededWithin condition is true when job of the group succeeded within duration before time of check
+func (g *Group) JobSucceededWithin(name string, d time.Duration) Condition {
+	return g.jobCondition(name+" succeeded within "+d.String(), name, func(job *Job, t time.Time) bool {
+		last := job.LastSuccessAt()
+		return !last.IsZero() && t.Sub(last) <= d
+	})
+}
+
+// JobFailed condition is true when last finished run of job of the group returned error
+func (g *Group) JobFailed(name string) Condition {
+	return g.jobCondition(name+" failed", name, func(job *Job, t time.Time) bool {
+		return job.LastError() != nil
+	})
+}
+
+// JobRunning condition is true when job of the group is running
+func (g *Group) JobRunning(name string) Condition {
+	return g.jobCondition(name+" running", name, func(job *Job, t time.Time) bool {
+		return job.IsRunning()
+	})
+}
+
+// jobCondition condition on outcome of job of the group
+// Job is resolved on each check, not found job returns ErrJobNotFound
+func (g *Group) jobCondition(label string, name string, check func(job *Job, t time.Time) bool) Condition {
+	return NewCondition(OperatorAND).AddNamedContextExpression(label, func(ctx context.Context, t time.Time) (bool, error) {
+		job := g.GetJob(name)
+		if job == nil {
+			return false, ErrJobNotFound
+		}
+		return check(job, t), nil
+	})
+}
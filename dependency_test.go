@@ -0,0 +1,73 @@
+package gojob
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestGroup_JobDependency(t *testing.T) {
+	var result error
+	release := make(chan struct{})
+	g := NewGroup(time.Millisecond*10, GroupModeConsistently)
+	etl := NewJob("etl.load", func(ctx context.Context, args ...any) error {
+		if len(args) > 0 {
+			<-release
+		}
+		return result
+	}, time.Millisecond)
+	g.AddJob(etl)
+	ctx := context.Background()
+	check := func(cond Condition, tm time.Time, expected bool) {
+		t.Helper()
+		ok, err := cond.Evaluate(ctx, tm)
+		if err != nil || ok != expected {
+			t.Fatal("wrong result", ok, err)
+		}
+	}
+	succeeded := g.JobSucceededWithin("etl.load", time.Hour)
+	check(succeeded, time.Now(), false)
+	result = errors.New("load failed")
+	_ = etl.Run(ctx)
+	check(succeeded, time.Now(), false)
+	check(g.JobFailed("etl.load"), time.Now(), true)
+	result = nil
+	_ = etl.Run(ctx)
+	check(succeeded, time.Now(), true)
+	check(succeeded, time.Now().Add(time.Hour*2), false)
+	check(g.JobFailed("etl.load"), time.Now(), false)
+
+	running := g.JobRunning("etl.load")
+	check(running, time.Now(), false)
+	done := make(chan struct{})
+	go func() {
+		_ = etl.Run(ctx, true)
+		close(done)
+	}()
+	for !etl.IsRunning() {
+		time.Sleep(time.Millisecond)
+	}
+	check(running, time.Now(), true)
+	close(release)
+	<-done
+	check(running, time.Now(), false)
+
+	if _, err := g.JobRunning("missing").Evaluate(ctx, time.Now()); !errors.Is(err, ErrJobNotFound) {
+		t.Fatal("must be not found error", err)
+	}
+}
+
+func TestGroup_JobDependencySelf(t *testing.T) {
+	var runs int
+	g := NewGroup(time.Millisecond*10, GroupModeConsistently)
+	job := NewJob("self", func(ctx context.Context, args ...any) error {
+		runs++
+		return nil
+	}, time.Millisecond)
+	job.SetCondition(Not(g.JobRunning("self")))
+	g.AddJob(job)
+	if err := job.RunAt(context.Background(), time.Now()); err != nil || runs != 1 {
+		t.Fatal("job must run", err)
+	}
+}
@@ -0,0 +1,291 @@
+package gojob
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Explanation result of condition check with results of each expression and merged condition
+type Explanation struct {
+	// Operator of condition. Empty for expression
+	Operator Operator `yaml:"operator,omitempty" json:"operator,omitempty"`
+	// Merge operator of condition. Empty for expression
+	Merge Operator `yaml:"merge,omitempty" json:"merge,omitempty"`
+	// Name of expression. Empty for anonymous expression and condition
+	Name string `yaml:"name,omitempty" json:"name,omitempty"`
+	// Result of check
+	Result bool `yaml:"result" json:"result"`
+	// Error of context expression or condition timeout. Result of expression with error is false
+	// Result of timed out condition is defined by timeout policy
+	Error string `yaml:"error,omitempty" json:"error,omitempty"`
+	// Operand is not checked because result of condition is known before. Result is false
+	Unchecked bool `yaml:"unchecked,omitempty" json:"unchecked,omitempty"`
+	// Time of check. Defined for root explanation only
+	At time.Time `yaml:"at,omitempty" json:"at,omitempty"`
+	// Explanations of expressions and merged conditions
+	Children []Explanation `yaml:"children,omitempty" json:"children,omitempty"`
+}
+
+// Explain check condition and return result of each expression and merged condition
+// All expressions are evaluated, even when result is known before
+func (c Condition) Explain(t time.Time) Explanation {
+	return c.ExplainContext(context.Background(), t)
+}
+
+// ExplainContext check condition at t with context and return result of each expression and merged condition
+func (c Condition) ExplainContext(ctx context.Context, t time.Time) Explanation {
+	e, _ := c.explain(ctx, t)
+	e.At = t
+	return e
+}
+
+// explain condition without time of check. Error is returned when result is unknown
+func (c Condition) explain(ctx context.Context, t time.Time) (Explanation, error) {
+	if c.timeout > 0 {
+		return c.explainTimeout(ctx, t)
+	}
+	return c.explainOperator(ctx, t)
+}
+
+// explainTimeout explain condition with timeout
+// Timed out condition has result according to timeout policy
+func (c Condition) explainTimeout(ctx context.Context, t time.Time) (Explanation, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+	type explanation struct {
+		e   Explanation
+		err error
+	}
+	result := make(chan explanation, 1)
+	go func() {
+		e, err := c.explainOperator(ctx, t)
+		result <- explanation{e: e, err: err}
+	}()
+	select {
+	case r := <-result:
+		if r.err == nil || !errors.Is(r.err, context.DeadlineExceeded) || ctx.Err() == nil {
+			return r.e, r.err
+		}
+	case <-ctx.Done():
+	}
+	if errors.Is(ctx.Err(), context.Canceled) {
+		return Explanation{Operator: c.op, Merge: c.mor, Error: ctx.Err().Error()}, ctx.Err()
+	}
+	return Explanation{
+		Operator: c.op,
+		Merge:    c.mor,
+		Result:   c.timeoutPolicy == TimeoutRun,
+		Error:    ErrConditionTimeout.Error(),
+	}, nil
+}
+
+// explainOperator explain condition according to operator
+func (c Condition) explainOperator(ctx context.Context, t time.Time) (Explanation, error) {
+	n := len(c.expressions) + len(c.contextExpressions)
+	errs := make([]error, 0, n+len(c.conditions))
+	e := Explanation{
+		Operator: c.op,
+		Merge:    c.mor,
+		Children: make([]Explanation, 0, n+len(c.conditions)),
+	}
+	for i := range c.expressions {
+		var name string
+		if i < len(c.names) {
+			name = c.names[i]
+		}
+		e.Children = append(e.Children, Explanation{Name: name, Result: c.expressions[i]()})
+		errs = append(errs, nil)
+	}
+	for i := range c.contextExpressions {
+		child := Explanation{Name: c.contextExpressions[i].name}
+		ok, err := c.contextExpressions[i].expression(ctx, t)
+		if err != nil {
+			child.Error = err.Error()
+		} else {
+			child.Result = ok
+		}
+		e.Children = append(e.Children, child)
+		errs = append(errs, err)
+	}
+	for i := range c.conditions {
+		child, err := c.conditions[i].explain(ctx, t)
+		e.Children = append(e.Children, child)
+		errs = append(errs, err)
+	}
+	op, mor := c.op, c.mor
+	if op == OperatorNOT {
+		op, mor = OperatorAND, OperatorAND
+	}
+	if mor == "" {
+		mor = op
+	}
+	result := func(i int) (bool, error) {
+		return e.Children[i].Result, errs[i]
+	}
+	expressions := func() (bool, error) {
+		return join(op, n, result)
+	}
+	var err error
+	switch {
+	case len(c.conditions) == 0:
+		e.Result, err = expressions()
+	case n == 0:
+		e.Result, err = join(mor, len(c.conditions), result)
+	default:
+		e.Result, err = join(mor, len(c.conditions)+1, func(i int) (bool, error) {
+			if i == 0 {
+				return expressions()
+			}
+			return result(n + i - 1)
+		})
+	}
+	if err == nil && c.op == OperatorNOT {
+		e.Result = !e.Result
+	}
+	return e, err
+}
+
+// tracer records results of checked operands
+type tracer struct {
+	// results are not recorded after check is finished
+	done bool
+	// operands of parallel condition are recorded concurrently
+	m sync.Mutex
+}
+
+// record result of checked operand
+func (tr *tracer) record(e *Explanation, result bool, err error) {
+	tr.m.Lock()
+	defer tr.m.Unlock()
+	if tr.done {
+		return
+	}
+	e.Unchecked = false
+	e.Result = result
+	if err != nil {
+		e.Error = err.Error()
+	}
+}
+
+// trace check condition at t and explain checked operands
+// Result and error are the same as of Evaluate: checks stop when result is known, SetParallel and SetTimeout are applied
+func (c Condition) trace(ctx context.Context, t time.Time) (Explanation, error) {
+	tr := &tracer{}
+	e := &Explanation{}
+	_, err := c.traced(tr, e).check(ctx, t, tr, e)
+	tr.m.Lock()
+	tr.done = true
+	tr.m.Unlock()
+	e.At = t
+	return *e, err
+}
+
+// traced copy of condition which records results of checked operands to e
+func (c Condition) traced(tr *tracer, e *Explanation) Condition {
+	n := len(c.expressions) + len(c.contextExpressions)
+	e.Operator, e.Merge, e.Unchecked = c.op, c.mor, true
+	e.Children = make([]Explanation, n+len(c.conditions))
+	traced := Condition{
+		op:                 c.op,
+		mor:                c.mor,
+		expressions:        make([]Expression, len(c.expressions)),
+		contextExpressions: make([]contextOperand, len(c.contextExpressions)),
+		conditions:         make(Conditions, len(c.conditions)),
+		timeout:            c.timeout,
+		timeoutPolicy:      c.timeoutPolicy,
+		parallel:           c.parallel,
+	}
+	for i := range c.expressions {
+		child, expression := &e.Children[i], c.expressions[i]
+		child.Unchecked = true
+		if i < len(c.names) {
+			child.Name = c.names[i]
+		}
+		traced.expressions[i] = func() bool {
+			ok := expression()
+			tr.record(child, ok, nil)
+			return ok
+		}
+	}
+	for i, o := range c.contextExpressions {
+		child, expression := &e.Children[len(c.expressions)+i], o.expression
+		child.Name, child.Unchecked = o.name, true
+		o.expression = func(ctx context.Context, t time.Time) (bool, error) {
+			ok, err := expression(ctx, t)
+			tr.record(child, ok && err == nil, err)
+			return ok, err
+		}
+		traced.contextExpressions[i] = o
+	}
+	for i := range c.conditions {
+		child := &e.Children[n+i]
+		condition := c.conditions[i].traced(tr, child)
+		traced.conditions[i] = NewContextCondition(OperatorAND, func(ctx context.Context, t time.Time) (bool, error) {
+			return condition.check(ctx, t, tr, child)
+		})
+	}
+	return traced
+}
+
+// check traced condition and record the result to e
+func (c Condition) check(ctx context.Context, t time.Time, tr *tracer, e *Explanation) (bool, error) {
+	if c.timeout > 0 {
+		ok, timedOut, err := c.evaluateTimeout(ctx, t)
+		if timedOut {
+			tr.record(e, ok, ErrConditionTimeout)
+		} else {
+			tr.record(e, ok && err == nil, nil)
+		}
+		return ok, err
+	}
+	ok, err := c.evaluateOperator(ctx, t)
+	tr.record(e, ok && err == nil, nil)
+	return ok, err
+}
+
+// String explanation as indented tree
+func (e Explanation) String() string {
+	var b strings.Builder
+	e.write(&b, 0, 0)
+	return strings.TrimRight(b.String(), "\n")
+}
+
+// write explanation tree
+func (e Explanation) write(b *strings.Builder, depth int, index int) {
+	b.WriteString(strings.Repeat("  ", depth))
+	switch {
+	case e.Name != "":
+		b.WriteString(e.Name)
+	case e.Operator == "":
+		b.WriteString("expression #" + strconv.Itoa(index+1))
+	case e.Merge != "" && e.Merge != e.Operator && e.Operator != OperatorNOT:
+		b.WriteString(string(e.Operator) + "/" + string(e.Merge))
+	default:
+		b.WriteString(string(e.Operator))
+	}
+	if e.Unchecked {
+		b.WriteString(": not checked")
+	} else {
+		b.WriteString(": " + strconv.FormatBool(e.Result))
+	}
+	if e.Error != "" {
+		b.WriteString(" (error: " + e.Error + ")")
+	}
+	b.WriteString("\n")
+	for i := range e.Children {
+		e.Children[i].write(b, depth+1, i)
+	}
+}
+
+// SkipReason explain why job can't start at t. Empty string if job can start
+func (j *Job) SkipReason(t time.Time) string {
+	reason, _, _ := j.check(context.Background(), t, true)
+	if reason == "" && j.isBusy() {
+		return reasonBusy
+	}
+	return reason
+}
@@ -0,0 +1,204 @@
+package gojob
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+type testLogger struct {
+	lines []string
+	m     sync.Mutex
+}
+
+func (l *testLogger) Print(v ...interface{}) {
+	l.m.Lock()
+	defer l.m.Unlock()
+	l.lines = append(l.lines, fmt.Sprint(v...))
+}
+
+func (l *testLogger) Println(v ...interface{}) {
+	l.Print(v...)
+}
+
+func (l *testLogger) Printf(format string, v ...interface{}) {
+	l.Print(fmt.Sprintf(format, v...))
+}
+
+func (l *testLogger) contains(s string) bool {
+	l.m.Lock()
+	defer l.m.Unlock()
+	for _, line := range l.lines {
+		if strings.Contains(line, s) {
+			return true
+		}
+	}
+	return false
+}
+
+func TestCondition_Explain(t *testing.T) {
+	RegisterPredicate("test.explain.primary", func() bool {
+		return false
+	})
+	defer UnregisterPredicate("test.explain.primary")
+	cond := NewCondition(OperatorAND).
+		AddNamedExpression("weekday", func() bool { return true }).
+		AddExpression(func() bool { return true }).
+		Merge(OperatorOR, UsePredicate("test.explain.primary"), Not(NewCondition(OperatorAND, func() bool { return true })))
+	now := time.Now()
+	e := cond.Explain(now)
+	if e.Result != cond.IsTrue() || !e.Result {
+		t.Fatal("explanation result must be equal to condition result")
+	}
+	if !e.At.Equal(now) || len(e.Children) != 4 {
+		t.Fatal("wrong explanation")
+	}
+	if e.Children[0].Name != "weekday" || !e.Children[0].Result {
+		t.Fatal("wrong named expression explanation")
+	}
+	if e.Children[2].Result || e.Children[2].Children[0].Name != "test.explain.primary" {
+		t.Fatal("wrong predicate explanation")
+	}
+	if e.Children[3].Operator != OperatorNOT || e.Children[3].Result {
+		t.Fatal("wrong not explanation")
+	}
+	expected := "AND/OR: true\n" +
+		"  weekday: true\n" +
+		"  expression #2: true\n" +
+		"  AND: false\n" +
+		"    test.explain.primary: false\n" +
+		"  NOT: false\n" +
+		"    AND: true\n" +
+		"      expression #1: true"
+	if e.String() != expected {
+		t.Fatal("wrong explanation text\n" + e.String())
+	}
+}
+
+func TestJob_SkipReason(t *testing.T) {
+	now := time.Now()
+	job := NewJob("test.skip", func(ctx context.Context, args ...any) error {
+		return nil
+	}, time.Minute)
+	if job.SkipReason(now) != "" {
+		t.Fatal("job must start")
+	}
+	job.SetCondition(mustParse(t, "- - - - - - - - 13").ToCondition())
+	if reason := job.SkipReason(now); !strings.Contains(reason, "schedule - - - - - - - - 13: false") {
+		t.Fatal("condition must be false", reason)
+	}
+	job.SetSchedule(ScheduleFunc(func(t time.Time) bool { return false }))
+	if job.SkipReason(now) != "schedule does not match" {
+		t.Fatal("schedule must not match")
+	}
+	job.SetNextTime(now.Add(time.Hour))
+	if !strings.HasPrefix(job.SkipReason(now), "next attempt at") {
+		t.Fatal("job must wait")
+	}
+	job.SetActiveWindow(now.Add(time.Hour), time.Time{})
+	if !strings.HasPrefix(job.SkipReason(now), "not active until") {
+		t.Fatal("job must be inactive")
+	}
+}
+
+func TestGroup_Debug(t *testing.T) {
+	logger := &testLogger{}
+	g := NewGroup(time.Millisecond*10, GroupModeConsistently).SetDebug(true)
+	g.AddJob(NewJob("test.debug", func(ctx context.Context, args ...any) error {
+		return nil
+	}, time.Millisecond).SetCondition(NewCondition(OperatorAND).AddNamedExpression("never", func() bool {
+		return false
+	})))
+	ctx := context.WithValue(context.Background(), "logger", logger)
+	ctx, cancel := context.WithTimeout(ctx, time.Millisecond*100)
+	defer cancel()
+	g.Schedule(ctx)
+	if !logger.contains("job: test.debug skipped: condition is false") || !logger.contains("never: false") {
+		t.Fatal("skip reason must be logged", logger.lines)
+	}
+}
+
+func TestGroup_ConditionError(t *testing.T) {
+	logger := &testLogger{}
+	var runs int
+	g := NewGroup(time.Millisecond*10, GroupModeConsistently)
+	g.AddJob(NewJob("test.condition.error", func(ctx context.Context, args ...any) error {
+		runs++
+		return nil
+	}, time.Millisecond).SetCondition(NewCondition(OperatorAND).AddNamedContextExpression("db", func(ctx context.Context, t time.Time) (bool, error) {
+		return false, errors.New("connection refused")
+	})))
+	ctx := context.WithValue(context.Background(), "logger", logger)
+	ctx, cancel := context.WithTimeout(ctx, time.Millisecond*50)
+	defer cancel()
+	g.Schedule(ctx)
+	if runs != 0 || !logger.contains("job: test.condition.error condition: db: connection refused") {
+		t.Fatal("condition error must be logged", logger.lines)
+	}
+}
+
+func TestCondition_Trace(t *testing.T) {
+	var calls atomic.Int32
+	counted := NewCondition(OperatorAND).AddNamedExpression("counted", func() bool {
+		calls.Add(1)
+		return true
+	})
+	no := NewCondition(OperatorAND).AddNamedExpression("no", func() bool { return false })
+	e, err := And(no, counted).trace(context.Background(), time.Now())
+	if err != nil || e.Result || calls.Load() != 0 || !e.Children[1].Unchecked {
+		t.Fatal("trace must stop when result is known", e)
+	}
+	expected := "AND: false\n" +
+		"  AND: false\n" +
+		"    no: false\n" +
+		"  AND: not checked\n" +
+		"    counted: not checked"
+	if e.String() != expected {
+		t.Fatal("wrong trace text\n" + e.String())
+	}
+	slow := NewContextCondition(OperatorAND, func(ctx context.Context, t time.Time) (bool, error) {
+		<-ctx.Done()
+		return false, ctx.Err()
+	})
+	sleeping := NewCondition(OperatorAND, func() bool {
+		time.Sleep(time.Millisecond * 50)
+		return false
+	})
+	e, err = Or(sleeping, NewCondition(OperatorAND, func() bool { return true })).SetParallel(true).trace(context.Background(), time.Now())
+	if err != nil || !e.Result || !e.Children[0].Unchecked || e.Children[1].Unchecked {
+		t.Fatal("trace must check operands concurrently", e)
+	}
+	e, err = slow.SetTimeout(time.Millisecond*10, TimeoutRun).trace(context.Background(), time.Now())
+	if err != nil || !e.Result || e.Error != ErrConditionTimeout.Error() {
+		t.Fatal("trace must apply timeout", e)
+	}
+}
+
+func TestGroup_DebugSingleCheck(t *testing.T) {
+	type key struct{}
+	var checks, scheduled atomic.Int32
+	logger := &testLogger{}
+	g := NewGroup(time.Millisecond*10, GroupModeAllParallel).SetDebug(true)
+	g.AddJob(NewJob("test.debug.single", func(ctx context.Context, args ...any) error {
+		return nil
+	}, time.Hour).SetCondition(NewContextCondition(OperatorAND, func(ctx context.Context, t time.Time) (bool, error) {
+		checks.Add(1)
+		if ctx.Value(key{}) != nil {
+			scheduled.Add(1)
+		}
+		return true, nil
+	})))
+	ctx := context.WithValue(context.Background(), "logger", logger)
+	ctx = context.WithValue(ctx, key{}, true)
+	ctx, cancel := context.WithTimeout(ctx, time.Millisecond*50)
+	defer cancel()
+	g.Schedule(ctx)
+	if checks.Load() != 1 || scheduled.Load() != 1 {
+		t.Fatal("condition must be checked once with scheduler context", checks.Load(), scheduled.Load())
+	}
+}
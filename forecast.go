@@ -0,0 +1,117 @@
+package gojob
+
+import "time"
+
+// Forecast expected job runs in a time range
+type Forecast struct {
+	// Number of runs per job name
+	Runs map[string]int
+	// Histogram of runs
+	Buckets []ForecastBucket
+}
+
+// ForecastBucket expected runs in a bucket of time range
+type ForecastBucket struct {
+	// Bucket start time
+	Start time.Time
+	// Number of job runs in bucket
+	Runs int
+	// Max number of jobs started at the same tick
+	Concurrent int
+}
+
+// RunsPer average number of runs during the period
+// Time slot of run is the repeat period of time part, group repeat duration is not considered
+func (t TimePart) RunsPer(period time.Duration) float64 {
+	slots := float64(period) / float64(t.GetRepeatPeriod())
+	tf := t.fields()
+	for i := partMillisecond; i <= partHour; i++ {
+		if len(*tf[i]) == 0 {
+			continue
+		}
+		var count int
+		for _, v := range normalizeValues(*tf[i]) {
+			if v >= timePartBounds[i][0] && v <= timePartBounds[i][1] {
+				count++
+			}
+		}
+		slots *= float64(count) / float64(timePartBounds[i][1]-timePartBounds[i][0]+1)
+	}
+	return slots * t.dayFraction()
+}
+
+// dayFraction part of calendar cycle days matching day parts
+func (t TimePart) dayFraction() float64 {
+	tf := t.fields()
+	var dayParts []int
+	for i := partDayOfWeek; i <= partMonth; i++ {
+		if len(*tf[i]) > 0 {
+			dayParts = append(dayParts, i)
+		}
+	}
+	if len(dayParts) == 0 {
+		return 1
+	}
+	var total, matched int
+	day := time.Date(lintCycleStart, time.January, 1, 0, 0, 0, 0, time.UTC)
+	for day.Year() < lintCycleStart+lintCycleYears {
+		if t.matchParts(day, dayParts...) {
+			matched++
+		}
+		total++
+		day = day.AddDate(0, 0, 1)
+	}
+	return float64(matched) / float64(total)
+}
+
+// Forecast simulate group ticks between from and to and count job runs
+// Job conditions are not evaluated, only schedule, repeat period, active window and max runs are considered
+// bucket - histogram bucket size, if 0 the whole range is one bucket
+func (g *Group) Forecast(from, to time.Time, bucket time.Duration) Forecast {
+	if bucket <= 0 {
+		bucket = to.Sub(from)
+	}
+	jobs := g.getJobs()
+	forecast := Forecast{Runs: make(map[string]int, len(jobs))}
+	if g.d <= 0 || bucket <= 0 {
+		return forecast
+	}
+	for start := from; start.Before(to); start = start.Add(bucket) {
+		forecast.Buckets = append(forecast.Buckets, ForecastBucket{Start: start})
+	}
+	// copy of job state for simulation
+	sim := make([]Job, len(jobs))
+	for i := range jobs {
+		jobs[i].m.Lock()
+		sim[i].schedule, sim[i].repeatPeriod = jobs[i].schedule, jobs[i].repeatPeriod
+		sim[i].nextAttemptAt, sim[i].runs, sim[i].maxRuns = jobs[i].nextAttemptAt, jobs[i].runs, jobs[i].maxRuns
+		sim[i].activeFrom, sim[i].activeTo = jobs[i].activeFrom, jobs[i].activeTo
+		jobs[i].m.Unlock()
+		forecast.Runs[jobs[i].name] = 0
+	}
+	for t := from.Add(g.d); t.Before(to); t = t.Add(g.d) {
+		var concurrent int
+		for i := range sim {
+			job := &sim[i]
+			if !job.isActive(t) || !job.isNextTime(t) {
+				continue
+			}
+			if job.schedule != nil && !job.schedule.Match(t) {
+				continue
+			}
+			job.nextAttemptAt = job.nextTime(t)
+			job.runs++
+			forecast.Runs[jobs[i].name]++
+			concurrent++
+		}
+		if concurrent == 0 {
+			continue
+		}
+		b := &forecast.Buckets[int(t.Sub(from)/bucket)]
+		b.Runs += concurrent
+		if concurrent > b.Concurrent {
+			b.Concurrent = concurrent
+		}
+	}
+	return forecast
+}
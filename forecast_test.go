@@ -0,0 +1,75 @@
+package gojob
+
+import (
+	"context"
+	"math"
+	"testing"
+	"time"
+)
+
+func TestTimePart_RunsPer(t *testing.T) {
+	cases := []struct {
+		exp    ScheduleExpression
+		period time.Duration
+		runs   float64
+	}{
+		{"- - 0 - - - - - -", time.Hour, 1},
+		{"- * - - - - - - -", time.Minute, 60},
+		{"- - - 5 - - - - -", time.Hour * 24, 1},
+		{"- - 0,30 9 1-5 - - - -", time.Hour * 24 * 7, 10},
+		{"- - - - - - - - -", time.Minute, 60},
+	}
+	for _, c := range cases {
+		t.Run(string(c.exp), func(t *testing.T) {
+			runs := mustParse(t, c.exp).RunsPer(c.period)
+			if math.Abs(runs-c.runs) > 0.01 {
+				t.Fatalf("expected %v runs, got %v", c.runs, runs)
+			}
+		})
+	}
+}
+
+func TestGroup_Forecast(t *testing.T) {
+	callback := func(ctx context.Context, args ...any) error { return nil }
+	hourly := mustParse(t, "- - 0 - - - - - -")
+	g := NewGroup(time.Minute, GroupModeConsistently)
+	g.AddJob(
+		NewJob("hourly.1", callback, time.Minute).SetSchedule(hourly),
+		NewJob("hourly.2", callback, time.Minute).SetSchedule(hourly),
+		NewJob("half.hour", callback, time.Minute*30),
+	)
+	from := time.Date(2026, 10, 19, 0, 0, 0, 0, time.UTC)
+	forecast := g.Forecast(from, from.Add(time.Hour*3), time.Hour)
+	if forecast.Runs["hourly.1"] != 2 || forecast.Runs["hourly.2"] != 2 {
+		t.Fatal("hourly jobs must run 2 times", forecast.Runs)
+	}
+	if forecast.Runs["half.hour"] != 6 {
+		t.Fatal("half hour job must run 6 times", forecast.Runs)
+	}
+	if len(forecast.Buckets) != 3 {
+		t.Fatal("must be 3 buckets")
+	}
+	if forecast.Buckets[0].Concurrent != 1 || forecast.Buckets[1].Concurrent != 2 {
+		t.Fatal("wrong concurrency", forecast.Buckets)
+	}
+	if forecast.Buckets[1].Runs != 4 {
+		t.Fatal("second bucket must contain 4 runs", forecast.Buckets[1])
+	}
+}
+
+func TestGroup_ForecastLifetime(t *testing.T) {
+	from := time.Date(2026, 10, 19, 0, 0, 0, 0, time.UTC)
+	callback := func(ctx context.Context, args ...any) error { return nil }
+	g := NewGroup(time.Minute, GroupModeConsistently)
+	g.AddJob(
+		NewJob("once", callback, time.Minute).SetMaxRuns(1),
+		NewJob("window", callback, time.Minute).SetActiveWindow(from.Add(time.Minute*30), from.Add(time.Minute*40)),
+	)
+	forecast := g.Forecast(from, from.Add(time.Hour), 0)
+	if forecast.Runs["once"] != 1 {
+		t.Fatal("job must run once", forecast.Runs)
+	}
+	if forecast.Runs["window"] != 6 {
+		t.Fatal("job must run inside window only", forecast.Runs)
+	}
+}
@@ -2,10 +2,20 @@ package gojob
 
 import (
 	"context"
+	"errors"
+	"slices"
 	"sort"
+	"sync"
 	"time"
 )
 
+var (
+	// ErrJobNotFound job is not found in group
+	ErrJobNotFound = errors.New("job not found")
+	// ErrJobDisabled job is disabled and can't be resumed
+	ErrJobDisabled = errors.New("job is disabled")
+)
+
 // GroupMode type of parallel mode
 type GroupMode int
 
@@ -27,6 +37,16 @@ type Group struct {
 	// parallel == 0 - no jobs will run in parallel mode
 	// parallel == N - specify the number N of jobs that can run in parallel mode
 	parallel GroupMode
+	// middlewares of running scheduler, applied to jobs added after start
+	middlewares []Middleware
+	// periods when jobs can't start
+	blackouts []blackout
+	// log reasons of skipped jobs
+	debug bool
+	// expressions which results are shared by jobs
+	cached map[string]*cachedExpression
+	// jobs list is replaced on change, so scheduler can iterate over it without lock
+	m sync.RWMutex
 }
 
 type parallelData struct {
@@ -35,8 +55,18 @@ type parallelData struct {
 	t      time.Time
 }
 
+// getJobs current list of jobs. The list must not be modified
+func (g *Group) getJobs() Jobs {
+	g.m.RLock()
+	defer g.m.RUnlock()
+	return g.jobs
+}
+
 // apply middlewares to jobs
 func (g *Group) applyMiddleware(middlewares ...Middleware) {
+	g.m.Lock()
+	defer g.m.Unlock()
+	g.middlewares = middlewares
 	for i := range g.jobs {
 		for _, middleware := range middlewares {
 			g.jobs[i].callback = middleware(g.jobs[i], g.jobs[i].callback)
@@ -44,6 +74,13 @@ func (g *Group) applyMiddleware(middlewares ...Middleware) {
 	}
 }
 
+// wrap job with middlewares of running scheduler
+func (g *Group) wrapJob(job *Job) {
+	for _, middleware := range g.middlewares {
+		job.callback = middleware(job, job.callback)
+	}
+}
+
 // extract logger
 func (g *Group) getLoggerFromContext(ctx context.Context) Logger {
 	l := ctx.Value("logger")
@@ -87,10 +124,7 @@ func (g *Group) Schedule(ctx context.Context, middlewares ...Middleware) {
 							break
 						}
 						// run job at provided time
-						e := d.job.RunAt(x, d.t)
-						if e != nil {
-							d.logger.Println(e.Error())
-						}
+						g.runJob(x, d.logger, d.job, d.t)
 					case <-q:
 						// close goroutine when context is done
 						return
@@ -105,20 +139,30 @@ func (g *Group) Schedule(ctx context.Context, middlewares ...Middleware) {
 		select {
 		case <-ticker.C:
 			now := time.Now()
-			for i := range g.jobs {
-				job := g.jobs[i]
+			jobs := g.getJobs()
+			blackout, policy := g.blackoutAt(now)
+			for i := range jobs {
+				job := jobs[i]
+				if job.IsExpired(now) {
+					g.removeJob(job)
+					job.expire()
+					logger.Printf("job: %s expired", job.GetName())
+					continue
+				}
+				if blackout && !job.IsIgnoreBlackout() {
+					if policy == BlackoutDefer && job.CanStartAt(now) {
+						job.deferRun()
+					}
+					if g.debug {
+						logger.Printf("job: %s skipped: blackout", job.GetName())
+					}
+					job.wait(false)
+					continue
+				}
 				if g.parallel == GroupModeAllParallel {
-					go func(j *Job, x context.Context, l Logger, t time.Time) {
-						e := j.RunAt(x, t)
-						if e != nil {
-							l.Println(e.Error())
-						}
-					}(job, ctx, logger, now)
+					go g.runJob(ctx, logger, job, now)
 				} else if g.parallel == GroupModeConsistently {
-					e := job.RunAt(ctx, now)
-					if e != nil {
-						logger.Println(e.Error())
-					}
+					g.runJob(ctx, logger, job, now)
 				} else if g.parallel > 0 {
 					dataChan <- parallelData{
 						logger: logger,
@@ -135,13 +179,47 @@ func (g *Group) Schedule(ctx context.Context, middlewares ...Middleware) {
 	}
 }
 
+// runJob run job at t and log the error
+// In debug mode condition is explained and reason of skip is logged
+func (g *Group) runJob(ctx context.Context, logger Logger, job *Job, t time.Time) {
+	run, previous, reason, err := job.acquireAt(ctx, t, g.debug)
+	if reason != "" && g.debug {
+		logger.Printf("job: %s skipped: %s", job.GetName(), reason)
+	}
+	if run == nil {
+		g.logError(logger, err)
+		return
+	}
+	if previous != nil {
+		// queued run waits for previous run in own goroutine, so scheduler and workers are not blocked
+		go func() {
+			g.logError(logger, job.runAcquired(ctx, t, run, previous))
+		}()
+		return
+	}
+	g.logError(logger, job.runAcquired(ctx, t, run, nil))
+}
+
+// logError log error of job run. Skipped runs are not logged
+func (g *Group) logError(logger Logger, err error) {
+	if err != nil && !errors.Is(err, Skip) {
+		logger.Println(err.Error())
+	}
+}
+
 // SetJob set job
 func (g *Group) SetJob(job ...*Job) *Group {
+	g.m.Lock()
+	defer g.m.Unlock()
+	jobs := slices.Clone(g.jobs)
 	for i := range job {
 		var found bool
-		for j := range g.jobs {
-			if job[i].name == g.jobs[j].name {
-				g.jobs[j] = job[i]
+		for j := range jobs {
+			if job[i].name == jobs[j].name {
+				if jobs[j] != job[i] {
+					g.wrapJob(job[i])
+				}
+				jobs[j] = job[i]
 				found = true
 			}
 			if found {
@@ -149,15 +227,117 @@ func (g *Group) SetJob(job ...*Job) *Group {
 			}
 		}
 		if !found {
-			g.jobs = append(g.jobs, job[i])
+			g.wrapJob(job[i])
+			jobs = append(jobs, job[i])
 		}
 	}
+	g.jobs = jobs
 	return g
 }
 
 // AddJob add jobs
 func (g *Group) AddJob(job ...*Job) *Group {
-	g.jobs = append(g.jobs, job...)
+	g.m.Lock()
+	defer g.m.Unlock()
+	for i := range job {
+		g.wrapJob(job[i])
+	}
+	g.jobs = append(slices.Clone(g.jobs), job...)
+	return g
+}
+
+// AddOnce add job which runs once at time t and then removed from the group
+func (g *Group) AddOnce(job *Job, t time.Time) *Group {
+	job.SetNextTime(t).SetMaxRuns(job.GetRuns() + 1)
+	return g.AddJob(job)
+}
+
+// RunOnceAt create job which runs once at time t and then removed from the group
+func (g *Group) RunOnceAt(name string, t time.Time, callback JobCallback) *Job {
+	job := NewJob(name, callback, g.d)
+	g.AddOnce(job, t)
+	return job
+}
+
+// GetJob get job by name. nil if job not found
+func (g *Group) GetJob(name string) *Job {
+	for _, job := range g.getJobs() {
+		if job.name == name {
+			return job
+		}
+	}
+	return nil
+}
+
+// RemoveJob remove job by name. false if job not found
+func (g *Group) RemoveJob(name string) bool {
+	g.m.Lock()
+	defer g.m.Unlock()
+	for i := range g.jobs {
+		if g.jobs[i].name == name {
+			g.jobs = slices.Delete(slices.Clone(g.jobs), i, i+1)
+			return true
+		}
+	}
+	return false
+}
+
+// removeJob remove exact job from the list
+func (g *Group) removeJob(job *Job) {
+	g.m.Lock()
+	defer g.m.Unlock()
+	if i := slices.Index(g.jobs, job); i > -1 {
+		g.jobs = slices.Delete(slices.Clone(g.jobs), i, i+1)
+	}
+}
+
+// Pause job by name. Paused job is not started until resumed
+func (g *Group) Pause(name string) error {
+	job := g.GetJob(name)
+	if job == nil {
+		return ErrJobNotFound
+	}
+	job.Pause()
+	return nil
+}
+
+// Resume paused job by name. Returns ErrJobDisabled for disabled job
+func (g *Group) Resume(name string) error {
+	job := g.GetJob(name)
+	if job == nil {
+		return ErrJobNotFound
+	}
+	if job.IsDisabled() {
+		return ErrJobDisabled
+	}
+	job.Resume()
+	return nil
+}
+
+// Disable job by name. Disabled job is not started until enabled
+func (g *Group) Disable(name string) error {
+	job := g.GetJob(name)
+	if job == nil {
+		return ErrJobNotFound
+	}
+	job.Disable()
+	return nil
+}
+
+// Enable disabled job by name
+func (g *Group) Enable(name string) error {
+	job := g.GetJob(name)
+	if job == nil {
+		return ErrJobNotFound
+	}
+	job.Enable()
+	return nil
+}
+
+// SetDebug log reasons why jobs are skipped. Waiting for the next attempt time is not logged
+// Must be called before Schedule
+func (g *Group) SetDebug(debug bool) *Group {
+	g.debug = debug
 	return g
 }
 
@@ -169,15 +349,21 @@ func (g *Group) SetRepeatDuration(d time.Duration) *Group {
 
 // ResetJobs reset jobs
 func (g *Group) ResetJobs() *Group {
-	g.jobs = g.jobs[:0]
+	g.m.Lock()
+	defer g.m.Unlock()
+	g.jobs = nil
 	return g
 }
 
 // SortJobs reset jobs
 func (g *Group) SortJobs() *Group {
-	sort.Slice(g.jobs, func(i, j int) bool {
-		return g.jobs[i].GetSortOrder() < g.jobs[j].GetSortOrder()
+	g.m.Lock()
+	defer g.m.Unlock()
+	jobs := slices.Clone(g.jobs)
+	sort.Slice(jobs, func(i, j int) bool {
+		return jobs[i].GetSortOrder() < jobs[j].GetSortOrder()
 	})
+	g.jobs = jobs
 	return g
 }
 
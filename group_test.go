@@ -259,3 +259,48 @@ func TestPerformance(t *testing.T) {
 
 	printMemStat(t)
 }
+
+func TestGroup_Expired(t *testing.T) {
+	for _, mode := range []GroupMode{GroupModeConsistently, GroupModeAllParallel, 2} {
+		g := NewGroup(time.Millisecond*10, mode)
+		var count int64
+		job := NewJob("job.limited", func(ctx context.Context, args ...any) error {
+			atomic.AddInt64(&count, 1)
+			return nil
+		}, time.Millisecond).SetMaxRuns(3)
+		g.AddJob(job)
+		ctx := context.WithValue(context.Background(), "logger", log.Default())
+		ctx, cancel := context.WithTimeout(ctx, time.Millisecond*300)
+		g.Schedule(ctx)
+		cancel()
+		if atomic.LoadInt64(&count) != 3 {
+			t.Fatal("job must run 3 times", count)
+		}
+		if g.GetJob("job.limited") != nil {
+			t.Fatal("expired job must be removed")
+		}
+	}
+}
+
+func TestGroup_RunOnceAt(t *testing.T) {
+	g := NewGroup(time.Millisecond*10, GroupModeConsistently)
+	var count int64
+	ctx := context.WithValue(context.Background(), "logger", log.Default())
+	ctx, cancel := context.WithTimeout(ctx, time.Millisecond*500)
+	defer cancel()
+	go func() {
+		// job added after start must receive middlewares
+		time.Sleep(time.Millisecond * 50)
+		g.RunOnceAt("job.once", time.Now().Add(time.Millisecond*100), func(ctx context.Context, args ...any) error {
+			atomic.AddInt64(&count, 1)
+			panic("must be recovered")
+		})
+	}()
+	g.Schedule(ctx, RecoverMiddleware)
+	if atomic.LoadInt64(&count) != 1 {
+		t.Fatal("job must run once", count)
+	}
+	if g.GetJob("job.once") != nil {
+		t.Fatal("job must be removed after run")
+	}
+}
@@ -2,6 +2,8 @@ package gojob
 
 import (
 	"context"
+	"fmt"
+	"sync"
 	"time"
 )
 
@@ -15,6 +17,8 @@ type JobCallback func(ctx context.Context, args ...any) error
 type Job struct {
 	// Condition for the job
 	condition Condition
+	// Time slots when job can be started
+	schedule Schedule
 	// Job callback
 	callback JobCallback
 	// When job must be scheduled
@@ -25,6 +29,60 @@ type Job struct {
 	name string
 	// Sort order
 	sortOrder uint
+	// Job can't start before the time
+	activeFrom time.Time
+	// Job can't start after the time
+	activeTo time.Time
+	// Max number of runs. 0 - unlimited
+	maxRuns uint
+	// Number of started runs
+	runs uint
+	// Job ignores group blackouts
+	ignoreBlackout bool
+	// Job must start on next attempt regardless of schedule and condition
+	forced bool
+	// Number of runs in progress
+	running int
+	// Finish time of last successful run
+	lastSuccessAt time.Time
+	// Error of last finished run
+	lastError error
+	// Start time of last run
+	lastRunAt time.Time
+	// Duration of last finished run
+	lastDuration time.Duration
+	// Status of job except running and expired
+	status JobStatus
+	// Job is removed from group because it will never start
+	expired bool
+	// Job is not started until resumed
+	paused bool
+	// Job is not started until enabled
+	disabled bool
+	// What to do when job is due while previous run is in progress
+	concurrencyPolicy ConcurrencyPolicy
+	// Last started run in progress
+	current *jobRun
+	// Sequence number of the last reserved run
+	seq uint
+	// Job waits for the end of run in progress
+	queued bool
+	// Max duration of run. 0 - unlimited
+	timeout time.Duration
+	// Retry of failed runs
+	retryPolicy RetryPolicy
+	// Number of failed runs in a row
+	attempt uint
+	// Job waits for retry of failed run. Schedule is ignored
+	retrying bool
+	// Arguments of callback for runs without explicit arguments
+	args []any
+	// Provider of arguments added after args
+	argsProvider ArgsProvider
+	// job state must be thread safe
+	m sync.Mutex
+	// outcome of runs must be thread safe. Not locked during condition check
+	om sync.RWMutex
 }
 
 // isNextTime is next Time
@@ -35,12 +93,55 @@ func (j *Job) isNextTime(t time.Time) bool {
 	return j.nextAttemptAt.Before(t)
 }
 
+// isActive check if t is inside of active window and max runs is not reached
+func (j *Job) isActive(t time.Time) bool {
+	if !j.activeFrom.IsZero() && t.Before(j.activeFrom) {
+		return false
+	}
+	return !j.isOver(t)
+}
+
+// isOver check if active window is over or max runs is reached at t. Retry of failed run is allowed
+func (j *Job) isOver(t time.Time) bool {
+	if !j.activeTo.IsZero() && t.After(j.activeTo) {
+		return true
+	}
+	return j.maxRuns > 0 && j.runs >= j.maxRuns && !j.retrying
+}
+
+// isExpired check if job will never start after t
+// Job with run in progress is not expired, the run can be retried
+func (j *Job) isExpired(t time.Time) bool {
+	return j.isOver(t) && !j.IsRunning()
+}
+
 // SetCondition set job condition
 func (j *Job) SetCondition(c Condition) *Job {
+	j.m.Lock()
+	defer j.m.Unlock()
 	j.condition = c
 	return j
 }
 
+// SetSchedule set job schedule
+// For aligned schedule next attempt time is moved to the next schedule slot
+func (j *Job) SetSchedule(s Schedule) *Job {
+	j.m.Lock()
+	defer j.m.Unlock()
+	j.schedule = s
+	if aligned, ok := s.(AlignedSchedule); ok {
+		j.nextAttemptAt = aligned.Next(time.Now())
+	}
+	return j
+}
+
+// GetSchedule get job schedule
+func (j *Job) GetSchedule() Schedule {
+	j.m.Lock()
+	defer j.m.Unlock()
+	return j.schedule
+}
+
 // SetRepeatPeriod set job repeat ttl
 func (j *Job) SetRepeatPeriod(d time.Duration) *Job {
 	j.repeatPeriod = d
@@ -63,12 +164,128 @@ func (j *Job) GetSortOrder() uint {
 	return j.sortOrder
 }
 
+// SetActiveWindow set time window when job can start
+// start - job can't start before, zero value means no limit
+// end - job can't start after, zero value means no limit
+func (j *Job) SetActiveWindow(start, end time.Time) *Job {
+	j.m.Lock()
+	defer j.m.Unlock()
+	j.activeFrom = start
+	j.activeTo = end
+	return j
+}
+
+// SetMaxRuns set max number of runs. 0 - unlimited
+func (j *Job) SetMaxRuns(n uint) *Job {
+	j.m.Lock()
+	defer j.m.Unlock()
+	j.maxRuns = n
+	return j
+}
+
+// GetRuns get number of started runs
+func (j *Job) GetRuns() uint {
+	j.m.Lock()
+	defer j.m.Unlock()
+	return j.runs
+}
+
+// IsExpired check if job will never start after t
+func (j *Job) IsExpired(t time.Time) bool {
+	j.m.Lock()
+	defer j.m.Unlock()
+	return j.isExpired(t)
+}
+
+// SetIgnoreBlackout job starts during group blackouts
+func (j *Job) SetIgnoreBlackout(ignore bool) *Job {
+	j.m.Lock()
+	defer j.m.Unlock()
+	j.ignoreBlackout = ignore
+	return j
+}
+
+// IsIgnoreBlackout check if job starts during group blackouts
+func (j *Job) IsIgnoreBlackout() bool {
+	j.m.Lock()
+	defer j.m.Unlock()
+	return j.ignoreBlackout
+}
+
+// deferRun job will start on next attempt regardless of schedule and condition
+func (j *Job) deferRun() {
+	j.m.Lock()
+	defer j.m.Unlock()
+	j.forced = true
+}
+
 // CanStartAt is possible to start job now
 func (j *Job) CanStartAt(t time.Time) bool {
-	if j.condition.IsEmpty() {
-		return j.isNextTime(t)
+	reason, _, err := j.check(context.Background(), t, false)
+	return reason == "" && err == nil
+}
+
+// reasonBusy skip reason of job when new run is not allowed by concurrency policy
+const reasonBusy = "previous run is in progress"
+
+// check explain why job can't start at t. Empty reason if job can start
+// waiting is true when job waits for next attempt time, resume or enable
+// Condition is checked once without job lock, so expressions can use the job getters
+// With trace reason contains times and explanation of checked operands
+// Error is returned when condition can't be checked
+func (j *Job) check(ctx context.Context, t time.Time, trace bool) (reason string, waiting bool, err error) {
+	j.m.Lock()
+	reason, waiting, evaluate := j.checkState(t, trace)
+	condition := j.condition
+	j.m.Unlock()
+	if reason != "" || !evaluate {
+		return reason, waiting, nil
 	}
-	return j.isNextTime(t) && j.condition.IsTrue()
+	if !trace {
+		ok, err := condition.Evaluate(ctx, t)
+		if err != nil || !ok {
+			return "condition is false", false, err
+		}
+		return "", false, nil
+	}
+	e, err := condition.trace(ctx, t)
+	if err != nil || !e.Result {
+		return "condition is false\n" + e.String(), false, err
+	}
+	return "", false, nil
+}
+
+// checkState explain why job can't start at t without condition and without lock
+// evaluate is true when condition must be checked
+func (j *Job) checkState(t time.Time, trace bool) (reason string, waiting bool, evaluate bool) {
+	if !j.activeFrom.IsZero() && t.Before(j.activeFrom) {
+		if !trace {
+			return "not active", false, false
+		}
+		return "not active until " + j.activeFrom.Format(time.RFC3339), false, false
+	}
+	if j.isOver(t) {
+		return "expired", false, false
+	}
+	if j.IsDisabled() {
+		return JobStatusDisabled.String(), true, false
+	}
+	if j.isSuspended() {
+		return JobStatusPaused.String(), true, false
+	}
+	if j.forced {
+		return "", false, false
+	}
+	if !j.isNextTime(t) {
+		if !trace {
+			return "next attempt", true, false
+		}
+		return "next attempt at " + j.nextAttemptAt.Format(time.RFC3339Nano), true, false
+	}
+	if !j.retrying && j.schedule != nil && !j.schedule.Match(t) {
+		return "schedule does not match", false, false
+	}
+	return "", false, !j.condition.IsEmpty()
 }
 
 // GetName get job name
@@ -78,23 +295,123 @@ func (j *Job) GetName() string {
 
 // SetNextTime set next Time
 func (j *Job) SetNextTime(t time.Time) *Job {
+	j.m.Lock()
+	defer j.m.Unlock()
 	j.nextAttemptAt = t
 	return j
 }
 
 // Run job with params
-func (j *Job) Run(ctx context.Context, arg ...any) (err error) {
+// Context of the run is cancelled when run is replaced
+// Run with timeout returns ErrJobTimeout when deadline is exceeded
+// Job arguments are used when params are not passed
+func (j *Job) Run(ctx context.Context, arg ...any) error {
+	j.om.Lock()
+	run := j.reserve()
+	j.om.Unlock()
+	if len(arg) == 0 {
+		arg = j.runArgs(ctx, time.Now())
+	}
+	return j.run(ctx, run, false, arg...)
+}
+
+// run reserved run of job callback with params
+// Failed run is retried according to retry policy when retry is true
+func (j *Job) run(ctx context.Context, run *jobRun, retry bool, arg ...any) (err error) {
+	timeout := j.GetTimeout()
+	ctx = j.start(ctx, run, timeout)
+	if timeout > 0 {
+		return j.runTimeout(ctx, run, timeout, retry, arg...)
+	}
+	defer func() {
+		j.finish(run, err, retry)
+	}()
 	return j.callback(ctx, arg...)
 }
 
 // RunAt run at specific time
-func (j *Job) RunAt(ctx context.Context, t time.Time, arg ...any) (err error) {
-	if !j.CanStartAt(t) {
-		return
+// Returns error if job condition can't be checked
+// Job arguments provided at t are used when params are not passed
+func (j *Job) RunAt(ctx context.Context, t time.Time, arg ...any) error {
+	_, err := j.runAt(ctx, t, false, arg...)
+	return err
+}
+
+// runAt run at specific time. Returns reason why job is skipped
+// Reason is empty when job is started or waits for next attempt time, resume or enable
+// With trace reason contains explanation of checked condition operands
+func (j *Job) runAt(ctx context.Context, t time.Time, trace bool, arg ...any) (reason string, err error) {
+	run, previous, reason, err := j.acquireAt(ctx, t, trace)
+	if run == nil {
+		return reason, err
 	}
-	err = j.Run(ctx, arg...)
-	j.SetNextTime(t.Add(j.repeatPeriod))
-	return
+	return "", j.runAcquired(ctx, t, run, previous, arg...)
+}
+
+// acquireAt reserve run at specific time. Returns nil run and reason why job is skipped
+// previous is not nil when reserved run is queued after previous run
+func (j *Job) acquireAt(ctx context.Context, t time.Time, trace bool) (run, previous *jobRun, reason string, err error) {
+	reason, waiting, err := j.check(ctx, t, trace)
+	if err != nil || reason != "" {
+		reason, err = j.skip(t, reason, waiting, err)
+		return nil, nil, reason, err
+	}
+	j.m.Lock()
+	// state could be changed by another run while condition was checked
+	if reason, waiting, _ = j.checkState(t, trace); reason != "" {
+		j.m.Unlock()
+		reason, err = j.skip(t, reason, waiting, nil)
+		return nil, nil, reason, err
+	}
+	run, previous, ok := j.acquire()
+	if !ok {
+		j.m.Unlock()
+		return nil, nil, reasonBusy, nil
+	}
+	if !j.retrying {
+		j.runs++
+	}
+	j.forced = false
+	j.retrying = false
+	j.nextAttemptAt = j.nextTime(t)
+	j.m.Unlock()
+	return run, previous, "", nil
+}
+
+// runAcquired run reserved at t after previous run
+func (j *Job) runAcquired(ctx context.Context, t time.Time, run, previous *jobRun, arg ...any) error {
+	if err := j.waitRun(ctx, previous); err != nil {
+		j.release(run)
+		return err
+	}
+	// provider is called after the run is reserved, so slow provider doesn't break concurrency policy
+	if len(arg) == 0 {
+		arg = j.runArgs(ctx, t)
+	}
+	return j.run(ctx, run, true, arg...)
+}
+
+// skip job at t. Returns reason why job is skipped, empty when job waits
+func (j *Job) skip(t time.Time, reason string, waiting bool, err error) (string, error) {
+	j.m.Lock()
+	expired := j.isExpired(t)
+	j.m.Unlock()
+	j.wait(expired)
+	if err != nil {
+		return "", fmt.Errorf("job: %s condition: %w", j.name, err)
+	}
+	if waiting {
+		return "", nil
+	}
+	return reason, nil
+}
+
+// nextTime next attempt time after run at t
+func (j *Job) nextTime(t time.Time) time.Time {
+	if aligned, ok := j.schedule.(AlignedSchedule); ok {
+		return aligned.Next(t)
+	}
+	return t.Add(j.repeatPeriod)
 }
 
 // NewJob create new job
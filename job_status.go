@@ -0,0 +1,228 @@
+package gojob
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// JobStatus state of job
+type JobStatus uint8
+
+const (
+	// JobStatusIdle job is not checked by scheduler yet
+	JobStatusIdle JobStatus = iota
+	// JobStatusWaiting job is checked by scheduler and waits for the first run
+	JobStatusWaiting
+	// JobStatusRunning run of job is in progress
+	JobStatusRunning
+	// JobStatusSucceeded last run of job finished without error
+	JobStatusSucceeded
+	// JobStatusFailed last run of job finished with error
+	JobStatusFailed
+	// JobStatusPaused job is not started by scheduler until resumed
+	JobStatusPaused
+	// JobStatusDisabled job is not started by scheduler
+	JobStatusDisabled
+	// JobStatusExpired job will never start. Group removes expired jobs
+	JobStatusExpired
+	// JobStatusTimedOut last run of job exceeded timeout
+	JobStatusTimedOut
+	// JobStatusRetrying last run of job failed and job waits for retry
+	JobStatusRetrying
+	// JobStatusSkipped last run of job returned Skip
+	JobStatusSkipped
+)
+
+// job status names
+var jobStatusNames = [...]string{"idle", "waiting", "running", "succeeded", "failed", "paused", "disabled", "expired", "timed out", "retrying", "skipped"}
+
+// String name of status
+func (s JobStatus) String() string {
+	if int(s) < len(jobStatusNames) {
+		return jobStatusNames[s]
+	}
+	return "unknown"
+}
+
+// Status current state of job
+func (j *Job) Status() JobStatus {
+	j.om.RLock()
+	defer j.om.RUnlock()
+	switch {
+	case j.expired:
+		return JobStatusExpired
+	case j.running > 0:
+		return JobStatusRunning
+	case j.disabled:
+		return JobStatusDisabled
+	case j.paused:
+		return JobStatusPaused
+	}
+	return j.status
+}
+
+// Pause job is not started until resumed. Running job is not interrupted
+func (j *Job) Pause() *Job {
+	j.om.Lock()
+	defer j.om.Unlock()
+	j.paused = true
+	return j
+}
+
+// Resume paused job
+func (j *Job) Resume() *Job {
+	j.om.Lock()
+	defer j.om.Unlock()
+	j.paused = false
+	return j
+}
+
+// Disable job is not started until enabled. Resume doesn't enable the job
+func (j *Job) Disable() *Job {
+	j.om.Lock()
+	defer j.om.Unlock()
+	j.disabled = true
+	return j
+}
+
+// Enable disabled job
+func (j *Job) Enable() *Job {
+	j.om.Lock()
+	defer j.om.Unlock()
+	j.disabled = false
+	return j
+}
+
+// IsDisabled check if job is disabled
+func (j *Job) IsDisabled() bool {
+	j.om.RLock()
+	defer j.om.RUnlock()
+	return j.disabled
+}
+
+// isSuspended check if job is paused or disabled
+func (j *Job) isSuspended() bool {
+	j.om.RLock()
+	defer j.om.RUnlock()
+	return j.paused || j.disabled
+}
+
+// IsRunning check if job is running
+func (j *Job) IsRunning() bool {
+	j.om.RLock()
+	defer j.om.RUnlock()
+	return j.running > 0
+}
+
+// LastRunAt start time of last run. Zero if job never started
+func (j *Job) LastRunAt() time.Time {
+	j.om.RLock()
+	defer j.om.RUnlock()
+	return j.lastRunAt
+}
+
+// LastDuration duration of last finished run
+func (j *Job) LastDuration() time.Duration {
+	j.om.RLock()
+	defer j.om.RUnlock()
+	return j.lastDuration
+}
+
+// LastSuccessAt finish time of last successful run. Zero if job never succeeded
+func (j *Job) LastSuccessAt() time.Time {
+	j.om.RLock()
+	defer j.om.RUnlock()
+	return j.lastSuccessAt
+}
+
+// LastError error of last finished run. nil if last run succeeded
+func (j *Job) LastError() error {
+	j.om.RLock()
+	defer j.om.RUnlock()
+	return j.lastError
+}
+
+// wait job is checked and not started
+func (j *Job) wait(expired bool) {
+	j.om.Lock()
+	defer j.om.Unlock()
+	if j.status == JobStatusIdle {
+		j.status = JobStatusWaiting
+	}
+	if expired {
+		j.expired = true
+	}
+}
+
+// expire job is removed from group because it will never start
+func (j *Job) expire() {
+	j.om.Lock()
+	defer j.om.Unlock()
+	j.expired = true
+}
+
+// start save start of reserved run. Returns context of the run
+// timeout - deadline of the run context, 0 - no deadline
+func (j *Job) start(ctx context.Context, run *jobRun, timeout time.Duration) context.Context {
+	j.om.Lock()
+	defer j.om.Unlock()
+	if timeout > 0 {
+		ctx, run.cancel = context.WithTimeout(ctx, timeout)
+	} else {
+		ctx, run.cancel = context.WithCancel(ctx)
+	}
+	if run.cancelled {
+		run.cancel()
+	}
+	run.start = time.Now()
+	j.lastRunAt = run.start
+	j.queued = false
+	return ctx
+}
+
+// finish save outcome of the run when callback returns and schedule retry if retry is true
+// Outcome of timed out run is already saved
+// Retry is scheduled under job lock, so the job is not expired between the end of run and retry
+func (j *Job) finish(run *jobRun, err error, retry bool) {
+	j.m.Lock()
+	defer j.m.Unlock()
+	if j.save(run, err) && retry {
+		j.retry(err)
+	}
+}
+
+// save outcome of the run without job lock
+// Returns false when outcome must not be retried: run is timed out, replaced or superseded by the next run
+func (j *Job) save(run *jobRun, err error) bool {
+	j.om.Lock()
+	defer j.om.Unlock()
+	run.cancel()
+	close(run.done)
+	if j.current == run {
+		j.current = nil
+	}
+	j.running--
+	if run.timedOut || run.replaced {
+		return false
+	}
+	latest := run.seq == j.seq
+	now := time.Now()
+	j.lastDuration = now.Sub(run.start)
+	if errors.Is(err, Skip) {
+		j.status = JobStatusSkipped
+		return latest
+	}
+	j.lastError = err
+	if errors.Is(err, ErrJobTimeout) {
+		j.status = JobStatusTimedOut
+		return latest
+	}
+	if err != nil {
+		j.status = JobStatusFailed
+		return latest
+	}
+	j.status = JobStatusSucceeded
+	j.lastSuccessAt = now
+	return latest
+}
@@ -0,0 +1,147 @@
+package gojob
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestJob_Status(t *testing.T) {
+	var result error
+	release := make(chan struct{})
+	job := NewJob("test.status", func(ctx context.Context, args ...any) error {
+		if len(args) > 0 {
+			<-release
+		}
+		return result
+	}, time.Millisecond).SetMaxRuns(3)
+	if job.Status() != JobStatusIdle || !job.LastRunAt().IsZero() {
+		t.Fatal("new job must be idle")
+	}
+	now := time.Now()
+	job.SetNextTime(now.Add(time.Hour))
+	if err := job.RunAt(context.Background(), now); err != nil || job.Status() != JobStatusWaiting {
+		t.Fatal("job must wait", job.Status())
+	}
+	job.SetNextTime(time.Time{})
+	done := make(chan struct{})
+	go func() {
+		_ = job.RunAt(context.Background(), now, true)
+		close(done)
+	}()
+	for !job.IsRunning() {
+		time.Sleep(time.Millisecond)
+	}
+	if job.Status() != JobStatusRunning || job.LastRunAt().Before(now) {
+		t.Fatal("job must be running", job.Status())
+	}
+	time.Sleep(time.Millisecond * 10)
+	close(release)
+	<-done
+	if job.Status() != JobStatusSucceeded || job.LastDuration() < time.Millisecond*10 || job.LastError() != nil {
+		t.Fatal("job must succeed", job.Status(), job.LastDuration())
+	}
+	result = errors.New("failed")
+	_ = job.RunAt(context.Background(), now.Add(time.Minute))
+	if job.Status() != JobStatusFailed || !errors.Is(job.LastError(), result) {
+		t.Fatal("job must fail", job.Status())
+	}
+	result = nil
+	_ = job.RunAt(context.Background(), now.Add(time.Minute*2))
+	if job.Status() != JobStatusSucceeded {
+		t.Fatal("job must succeed", job.Status())
+	}
+	_ = job.RunAt(context.Background(), now.Add(time.Minute*3))
+	if job.Status() != JobStatusExpired || job.Status().String() != "expired" {
+		t.Fatal("job must expire", job.Status())
+	}
+}
+
+func TestGroup_ExpiredStatus(t *testing.T) {
+	g := NewGroup(time.Millisecond*10, GroupModeConsistently)
+	job := NewJob("test.status.expired", func(ctx context.Context, args ...any) error {
+		return nil
+	}, time.Millisecond).SetActiveWindow(time.Time{}, time.Now().Add(-time.Second))
+	g.AddJob(job)
+	ctx, cancel := context.WithTimeout(context.WithValue(context.Background(), "logger", &testLogger{}), time.Millisecond*50)
+	defer cancel()
+	g.Schedule(ctx)
+	if job.Status() != JobStatusExpired || g.GetJob("test.status.expired") != nil {
+		t.Fatal("expired job must be removed", job.Status())
+	}
+}
+
+func TestGroup_Pause(t *testing.T) {
+	var runs int
+	g := NewGroup(time.Millisecond*10, GroupModeConsistently)
+	job := NewJob("test.pause", func(ctx context.Context, args ...any) error {
+		runs++
+		return nil
+	}, 0)
+	g.AddJob(job)
+	ctx := context.Background()
+	if err := g.Pause("test.pause"); err != nil || job.Status() != JobStatusPaused {
+		t.Fatal("job must be paused", err)
+	}
+	_ = job.RunAt(ctx, time.Now())
+	if runs != 0 || job.SkipReason(time.Now()) != "paused" {
+		t.Fatal("paused job must not run")
+	}
+	if err := g.Resume("test.pause"); err != nil {
+		t.Fatal(err)
+	}
+	_ = job.RunAt(ctx, time.Now())
+	if runs != 1 || job.Status() != JobStatusSucceeded {
+		t.Fatal("resumed job must run", job.Status())
+	}
+	if err := g.Disable("test.pause"); err != nil || job.Status() != JobStatusDisabled {
+		t.Fatal("job must be disabled", err)
+	}
+	if err := g.Resume("test.pause"); !errors.Is(err, ErrJobDisabled) {
+		t.Fatal("disabled job must not be resumed", err)
+	}
+	_ = job.RunAt(ctx, time.Now())
+	if runs != 1 {
+		t.Fatal("disabled job must not run")
+	}
+	if err := g.Enable("test.pause"); err != nil {
+		t.Fatal(err)
+	}
+	_ = job.RunAt(ctx, time.Now())
+	if runs != 2 {
+		t.Fatal("enabled job must run")
+	}
+	for _, f := range []func(string) error{g.Pause, g.Resume, g.Disable, g.Enable} {
+		if err := f("missing"); !errors.Is(err, ErrJobNotFound) {
+			t.Fatal("must be not found error", err)
+		}
+	}
+}
+
+func TestGroup_PauseSchedule(t *testing.T) {
+	var runs atomic.Int32
+	g := NewGroup(time.Millisecond*10, GroupModeAllParallel)
+	g.AddJob(NewJob("test.pause.schedule", func(ctx context.Context, args ...any) error {
+		runs.Add(1)
+		return nil
+	}, time.Millisecond))
+	if err := g.Pause("test.pause.schedule"); err != nil {
+		t.Fatal(err)
+	}
+	ctx, cancel := context.WithCancel(context.WithValue(context.Background(), "logger", &testLogger{}))
+	defer cancel()
+	go g.Schedule(ctx)
+	time.Sleep(time.Millisecond * 50)
+	if runs.Load() != 0 {
+		t.Fatal("paused job must not run")
+	}
+	if err := g.Resume("test.pause.schedule"); err != nil {
+		t.Fatal(err)
+	}
+	time.Sleep(time.Millisecond * 50)
+	if runs.Load() == 0 {
+		t.Fatal("resumed job must run")
+	}
+}
@@ -74,3 +74,97 @@ func BenchmarkGroup_Schedule(b *testing.B) {
 	}
 	b.ReportAllocs()
 }
+
+func TestJob_ActiveWindow(t *testing.T) {
+	var runs int
+	start := time.Date(2026, 10, 19, 0, 0, 0, 0, time.UTC)
+	job := NewJob("campaign", func(ctx context.Context, args ...any) error {
+		runs++
+		return nil
+	}, time.Second).SetActiveWindow(start, start.Add(time.Hour))
+	if job.CanStartAt(start.Add(-time.Second)) {
+		t.Fatal("job must not start before window")
+	}
+	if job.IsExpired(start.Add(-time.Second)) {
+		t.Fatal("job must not be expired before window")
+	}
+	if err := job.RunAt(context.Background(), start.Add(time.Minute)); err != nil {
+		t.Fatal(err)
+	}
+	if runs != 1 {
+		t.Fatal("job must run inside window")
+	}
+	if job.CanStartAt(start.Add(time.Hour*2)) || !job.IsExpired(start.Add(time.Hour*2)) {
+		t.Fatal("job must be expired after window")
+	}
+}
+
+func TestJob_MaxRuns(t *testing.T) {
+	now := time.Now()
+	job := NewJob("limited", func(ctx context.Context, args ...any) error {
+		return nil
+	}, time.Second).SetMaxRuns(2)
+	for i := 0; i < 5; i++ {
+		if err := job.RunAt(context.Background(), now.Add(time.Duration(i)*time.Minute)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if job.GetRuns() != 2 {
+		t.Fatal("job must run 2 times", job.GetRuns())
+	}
+	if !job.IsExpired(now) {
+		t.Fatal("job must be expired")
+	}
+}
+
+func TestJob_ConditionUsesJob(t *testing.T) {
+	job := NewJob("test.condition.self", func(ctx context.Context, args ...any) error {
+		return nil
+	}, time.Millisecond)
+	job.SetCondition(NewCondition(OperatorAND).AddExpression(func() bool {
+		return job.GetRuns() < 3 && !job.IsRetrying() && !job.IsExpired(time.Now())
+	}))
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		now := time.Now()
+		for i := 0; i < 5; i++ {
+			job.RunAt(context.Background(), now.Add(time.Duration(i)*time.Second))
+		}
+		job.CanStartAt(now.Add(time.Minute))
+		job.SkipReason(now.Add(time.Minute))
+	}()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("condition using job getters must not block the job")
+	}
+	if job.GetRuns() != 3 || job.CanStartAt(time.Now().Add(time.Minute)) {
+		t.Fatal("condition must be checked", job.GetRuns())
+	}
+
+	g := NewGroup(time.Millisecond*5, GroupModeConsistently)
+	grouped := NewJob("test.condition.self.group", func(ctx context.Context, args ...any) error {
+		return nil
+	}, time.Millisecond)
+	grouped.SetCondition(NewCondition(OperatorAND).AddExpression(func() bool {
+		return grouped.GetRuns() < 3
+	}))
+	g.AddJob(grouped)
+	ctx := context.WithValue(context.Background(), "logger", &testLogger{})
+	ctx, cancel := context.WithTimeout(ctx, time.Millisecond*100)
+	defer cancel()
+	scheduled := make(chan struct{})
+	go func() {
+		defer close(scheduled)
+		g.Schedule(ctx)
+	}()
+	select {
+	case <-scheduled:
+	case <-time.After(time.Second):
+		t.Fatal("group must not be blocked")
+	}
+	if grouped.GetRuns() != 3 {
+		t.Fatal("group job must run while condition is true", grouped.GetRuns())
+	}
+}
@@ -0,0 +1,74 @@
+package gojob
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// cachedExpression expression which result is shared by jobs of the group
+type cachedExpression struct {
+	// the expression
+	expression ContextExpression
+	// result is reused during ttl. 0 - result is reused for the same time of check
+	ttl time.Duration
+	// time of check of cached result
+	at time.Time
+	// cached result
+	result bool
+	// result is computed
+	computed bool
+	// expression is computed once for concurrent checks
+	m sync.Mutex
+}
+
+// evaluate return cached result or compute it
+// Errors are not cached, expression is computed again on next check
+func (c *cachedExpression) evaluate(ctx context.Context, t time.Time) (bool, error) {
+	c.m.Lock()
+	defer c.m.Unlock()
+	if c.computed && c.isFresh(t) {
+		return c.result, nil
+	}
+	ok, err := c.expression(ctx, t)
+	if err != nil {
+		return false, err
+	}
+	c.result, c.at, c.computed = ok, t, true
+	return ok, nil
+}
+
+// isFresh check if cached result can be used at t
+func (c *cachedExpression) isFresh(t time.Time) bool {
+	if c.ttl <= 0 {
+		return t.Equal(c.at)
+	}
+	return !t.Before(c.at) && t.Sub(c.at) < c.ttl
+}
+
+// Cached condition with named expression which result is shared by jobs of the group
+// ttl - result is reused during ttl, 0 - result is computed once per group tick
+// Registering the same name again replaces the expression
+func (g *Group) Cached(name string, ttl time.Duration, expression ContextExpression) Condition {
+	g.m.Lock()
+	if g.cached == nil {
+		g.cached = make(map[string]*cachedExpression)
+	}
+	g.cached[name] = &cachedExpression{expression: expression, ttl: ttl}
+	g.m.Unlock()
+	return g.UseCached(name)
+}
+
+// UseCached condition with cached expression registered by Cached
+// Not registered expression returns ErrPredicateNotRegistered
+func (g *Group) UseCached(name string) Condition {
+	return NewCondition(OperatorAND).AddNamedContextExpression(name, func(ctx context.Context, t time.Time) (bool, error) {
+		g.m.RLock()
+		cached := g.cached[name]
+		g.m.RUnlock()
+		if cached == nil {
+			return false, ErrPredicateNotRegistered
+		}
+		return cached.evaluate(ctx, t)
+	})
+}
@@ -0,0 +1,126 @@
+package gojob
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestGroup_Cached(t *testing.T) {
+	ctx := context.Background()
+	t.Run("tick", func(t *testing.T) {
+		var calls int32
+		g := NewGroup(time.Millisecond*10, GroupModeConsistently)
+		primary := g.Cached("isPrimary", 0, func(ctx context.Context, t time.Time) (bool, error) {
+			atomic.AddInt32(&calls, 1)
+			return true, nil
+		})
+		first := NewJob("first", nil, time.Millisecond).SetCondition(primary)
+		second := NewJob("second", nil, time.Millisecond).SetCondition(And(primary, NewCondition(OperatorAND, func() bool { return true })))
+		tick := time.Now()
+		if !first.CanStartAt(tick) || !second.CanStartAt(tick) || calls != 1 {
+			t.Fatal("expression must be computed once per tick", calls)
+		}
+		if !first.CanStartAt(tick.Add(time.Millisecond)) || calls != 2 {
+			t.Fatal("expression must be computed on next tick", calls)
+		}
+	})
+	t.Run("ttl", func(t *testing.T) {
+		var calls int32
+		g := NewGroup(time.Millisecond*10, GroupModeConsistently)
+		cond := g.Cached("quota", time.Minute, func(ctx context.Context, t time.Time) (bool, error) {
+			calls++
+			return true, nil
+		})
+		now := time.Now()
+		for _, tm := range []time.Time{now, now.Add(time.Second * 30), now.Add(time.Second * 59)} {
+			if ok, err := cond.Evaluate(ctx, tm); !ok || err != nil {
+				t.Fatal("must be true", err)
+			}
+		}
+		if calls != 1 {
+			t.Fatal("result must be reused during ttl", calls)
+		}
+		_, _ = cond.Evaluate(ctx, now.Add(time.Minute))
+		if calls != 2 {
+			t.Fatal("result must be computed after ttl", calls)
+		}
+	})
+	t.Run("error", func(t *testing.T) {
+		var calls int32
+		g := NewGroup(time.Millisecond*10, GroupModeConsistently)
+		cond := g.Cached("flaky", time.Minute, func(ctx context.Context, t time.Time) (bool, error) {
+			calls++
+			if calls == 1 {
+				return false, errors.New("unavailable")
+			}
+			return true, nil
+		})
+		now := time.Now()
+		if _, err := cond.Evaluate(ctx, now); err == nil {
+			t.Fatal("must be error")
+		}
+		if ok, err := cond.Evaluate(ctx, now); !ok || err != nil || calls != 2 {
+			t.Fatal("error must not be cached", err)
+		}
+		if _, err := g.UseCached("missing").Evaluate(ctx, now); !errors.Is(err, ErrPredicateNotRegistered) {
+			t.Fatal("must be not registered error", err)
+		}
+	})
+	t.Run("concurrent", func(t *testing.T) {
+		var calls int32
+		g := NewGroup(time.Millisecond*10, GroupModeConsistently)
+		cond := g.Cached("slow", 0, func(ctx context.Context, t time.Time) (bool, error) {
+			atomic.AddInt32(&calls, 1)
+			time.Sleep(time.Millisecond * 20)
+			return true, nil
+		})
+		now := time.Now()
+		var wg sync.WaitGroup
+		for i := 0; i < 10; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				if ok, err := cond.Evaluate(ctx, now); !ok || err != nil {
+					t.Error("must be true", err)
+				}
+			}()
+		}
+		wg.Wait()
+		if calls != 1 {
+			t.Fatal("expression must be computed once", calls)
+		}
+	})
+	t.Run("schedule", func(t *testing.T) {
+		var m sync.Mutex
+		ticks := make(map[time.Time]int)
+		g := NewGroup(time.Millisecond*10, GroupModeAllParallel)
+		cond := g.Cached("tick", 0, func(ctx context.Context, t time.Time) (bool, error) {
+			m.Lock()
+			defer m.Unlock()
+			ticks[t]++
+			return true, nil
+		})
+		for _, name := range []string{"first", "second", "third"} {
+			g.AddJob(NewJob(name, func(ctx context.Context, args ...any) error {
+				return nil
+			}, time.Millisecond).SetCondition(cond))
+		}
+		ctx, cancel := context.WithTimeout(context.WithValue(ctx, "logger", &testLogger{}), time.Millisecond*100)
+		defer cancel()
+		g.Schedule(ctx)
+		m.Lock()
+		defer m.Unlock()
+		if len(ticks) == 0 {
+			t.Fatal("expression must be computed")
+		}
+		for tick, calls := range ticks {
+			if calls != 1 {
+				t.Fatal("expression must be computed once per tick", tick, calls)
+			}
+		}
+	})
+}
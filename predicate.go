@@ -0,0 +1,82 @@
+package gojob
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"sync"
+	"time"
+)
+
+// ErrPredicateNotRegistered predicate is not registered
+var ErrPredicateNotRegistered = errors.New("predicate is not registered")
+
+var (
+	// registered predicates by name
+	predicates = make(map[string]ContextExpression)
+	// predicates registry must be thread safe
+	predicatesMutex sync.RWMutex
+)
+
+// RegisterPredicate register named expression for conditions defined by name or condition expression
+// Registering the same name again replaces the expression
+func RegisterPredicate(name string, expression Expression) {
+	RegisterContextPredicate(name, func(ctx context.Context, t time.Time) (bool, error) {
+		return expression(), nil
+	})
+}
+
+// RegisterContextPredicate register named expression with context
+// Registering the same name again replaces the expression
+func RegisterContextPredicate(name string, expression ContextExpression) {
+	predicatesMutex.Lock()
+	defer predicatesMutex.Unlock()
+	predicates[name] = expression
+}
+
+// UnregisterPredicate remove named expression
+func UnregisterPredicate(name string) {
+	predicatesMutex.Lock()
+	defer predicatesMutex.Unlock()
+	delete(predicates, name)
+}
+
+// IsPredicateRegistered check if predicate is registered
+func IsPredicateRegistered(name string) bool {
+	return getPredicate(name) != nil
+}
+
+// Predicates names of registered predicates
+func Predicates() []string {
+	predicatesMutex.RLock()
+	defer predicatesMutex.RUnlock()
+	names := make([]string, 0, len(predicates))
+	for name := range predicates {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// getPredicate find registered expression. nil if not found
+func getPredicate(name string) ContextExpression {
+	predicatesMutex.RLock()
+	defer predicatesMutex.RUnlock()
+	return predicates[name]
+}
+
+// UsePredicate condition with registered predicate
+// Predicate is resolved on each check, not registered predicate returns ErrPredicateNotRegistered
+func UsePredicate(name string) Condition {
+	return NewCondition(OperatorAND).addNamedOperand(contextOperand{
+		name: name,
+		expression: func(ctx context.Context, t time.Time) (bool, error) {
+			expression := getPredicate(name)
+			if expression == nil {
+				return false, ErrPredicateNotRegistered
+			}
+			return expression(ctx, t)
+		},
+		predicate: name,
+	})
+}
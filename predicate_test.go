@@ -0,0 +1,53 @@
+package gojob
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestRegisterPredicate(t *testing.T) {
+	var value bool
+	RegisterPredicate("test.registry.value", func() bool {
+		return value
+	})
+	defer UnregisterPredicate("test.registry.value")
+	if !IsPredicateRegistered("test.registry.value") || !slices.Contains(Predicates(), "test.registry.value") {
+		t.Fatal("predicate must be registered")
+	}
+	cond := UsePredicate("test.registry.value")
+	if cond.IsTrue() {
+		t.Fatal("must be false")
+	}
+	value = true
+	if !cond.IsTrue() {
+		t.Fatal("must be true")
+	}
+	RegisterPredicate("test.registry.value", func() bool {
+		return false
+	})
+	if cond.IsTrue() {
+		t.Fatal("replaced predicate must be used")
+	}
+	UnregisterPredicate("test.registry.value")
+	if IsPredicateRegistered("test.registry.value") || cond.IsTrue() {
+		t.Fatal("not registered predicate must be false")
+	}
+	if _, err := cond.Evaluate(context.Background(), time.Now()); !errors.Is(err, ErrPredicateNotRegistered) {
+		t.Fatal("must be not registered error", err)
+	}
+}
+
+func TestRegisterContextPredicate(t *testing.T) {
+	RegisterContextPredicate("test.registry.context", func(ctx context.Context, t time.Time) (bool, error) {
+		return t.Hour() == 10, nil
+	})
+	defer UnregisterPredicate("test.registry.context")
+	cond := UsePredicate("test.registry.context")
+	ok, err := cond.Evaluate(context.Background(), time.Date(2026, 1, 5, 10, 0, 0, 0, time.UTC))
+	if err != nil || !ok {
+		t.Fatal("must be true", err)
+	}
+}
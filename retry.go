@@ -0,0 +1,121 @@
+package gojob
+
+import (
+	"errors"
+	"math"
+	"math/rand/v2"
+	"time"
+)
+
+// RetryPolicy retry of failed runs
+// Retry is scheduled by scheduler after delay. Schedule is ignored for retry, condition is checked
+// Errors returned by Permanent are not retried, errors returned by RetryAfter are retried after requested delay
+type RetryPolicy struct {
+	// Max number of runs including the first one. 0 or 1 - no retries
+	MaxAttempts uint
+	// Delay before the first retry
+	Delay time.Duration
+	// Delay of each next retry is multiplied. Values <= 1 means constant delay
+	Multiplier float64
+	// Max delay before retry. 0 - unlimited
+	MaxDelay time.Duration
+	// Part of delay randomly added or subtracted, from 0 to 1
+	Jitter float64
+	// Check if run with error must be retried. nil - all errors are retried
+	Retryable func(err error) bool
+}
+
+// ConstantBackoff retry policy with the same delay before each retry
+func ConstantBackoff(maxAttempts uint, delay time.Duration) RetryPolicy {
+	return RetryPolicy{MaxAttempts: maxAttempts, Delay: delay}
+}
+
+// ExponentialBackoff retry policy with delay doubled before each next retry
+func ExponentialBackoff(maxAttempts uint, delay time.Duration, maxDelay time.Duration) RetryPolicy {
+	return RetryPolicy{MaxAttempts: maxAttempts, Delay: delay, Multiplier: 2, MaxDelay: maxDelay}
+}
+
+// SetJitter set part of delay randomly added or subtracted
+func (p RetryPolicy) SetJitter(jitter float64) RetryPolicy {
+	p.Jitter = jitter
+	return p
+}
+
+// SetRetryable set check if run with error must be retried
+func (p RetryPolicy) SetRetryable(retryable func(err error) bool) RetryPolicy {
+	p.Retryable = retryable
+	return p
+}
+
+// isRetryable check if run with error must be retried
+func (p RetryPolicy) isRetryable(err error) bool {
+	return err != nil && (p.Retryable == nil || p.Retryable(err))
+}
+
+// delay before retry. attempt is number of failed runs
+func (p RetryPolicy) delay(attempt uint) time.Duration {
+	d := float64(p.Delay)
+	if p.Multiplier > 1 && attempt > 1 {
+		d *= math.Pow(p.Multiplier, float64(attempt-1))
+	}
+	if p.MaxDelay > 0 && d > float64(p.MaxDelay) {
+		d = float64(p.MaxDelay)
+	}
+	if p.Jitter > 0 {
+		d += d * math.Min(p.Jitter, 1) * (rand.Float64()*2 - 1)
+	}
+	return time.Duration(d)
+}
+
+// SetRetryPolicy set retry of failed runs
+func (j *Job) SetRetryPolicy(policy RetryPolicy) *Job {
+	j.m.Lock()
+	defer j.m.Unlock()
+	j.retryPolicy = policy
+	j.attempt = 0
+	return j
+}
+
+// GetRetryPolicy get retry of failed runs
+func (j *Job) GetRetryPolicy() RetryPolicy {
+	j.m.Lock()
+	defer j.m.Unlock()
+	return j.retryPolicy
+}
+
+// IsRetrying check if job waits for retry of failed run
+func (j *Job) IsRetrying() bool {
+	j.m.Lock()
+	defer j.m.Unlock()
+	return j.retrying
+}
+
+// retry schedule retry of the run finished with err without job lock
+// Failed attempts and retry are reset when run succeeded, skipped, failed permanently or retries are exhausted
+func (j *Job) retry(err error) {
+	if err == nil || errors.Is(err, Skip) || IsPermanent(err) {
+		j.attempt = 0
+		j.retrying = false
+		return
+	}
+	exhausted := j.attempt+1 >= j.retryPolicy.MaxAttempts
+	delay, requested := RetryAfterDelay(err)
+	if requested {
+		// retry requested by callback is limited only when max attempts is set
+		exhausted = exhausted && j.retryPolicy.MaxAttempts > 0
+	} else {
+		exhausted = exhausted || !j.retryPolicy.isRetryable(err)
+		delay = j.retryPolicy.delay(j.attempt + 1)
+	}
+	if exhausted {
+		j.attempt = 0
+		j.retrying = false
+		return
+	}
+	j.attempt++
+	j.retrying = true
+	j.nextAttemptAt = time.Now().Add(delay)
+	j.om.Lock()
+	j.status = JobStatusRetrying
+	j.om.Unlock()
+}
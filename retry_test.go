@@ -0,0 +1,176 @@
+package gojob
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestRetryPolicy_Delay(t *testing.T) {
+	constant := ConstantBackoff(3, time.Second)
+	if constant.delay(1) != time.Second || constant.delay(3) != time.Second {
+		t.Fatal("delay must be constant")
+	}
+	exponential := ExponentialBackoff(5, time.Second, time.Second*5)
+	if exponential.delay(1) != time.Second || exponential.delay(2) != time.Second*2 || exponential.delay(3) != time.Second*4 {
+		t.Fatal("delay must be doubled")
+	}
+	if exponential.delay(4) != time.Second*5 {
+		t.Fatal("delay must be limited", exponential.delay(4))
+	}
+	jitter := constant.SetJitter(0.5)
+	for i := 0; i < 100; i++ {
+		if d := jitter.delay(1); d < time.Millisecond*500 || d > time.Millisecond*1500 {
+			t.Fatal("wrong jitter", d)
+		}
+	}
+}
+
+func TestJob_SetRetryPolicy(t *testing.T) {
+	errTemporary := errors.New("temporary")
+	errFatal := errors.New("fatal")
+	var calls atomic.Int32
+	var result atomic.Value
+	job := NewJob("test.retry", func(ctx context.Context, args ...any) error {
+		calls.Add(1)
+		if err, ok := result.Load().(error); ok {
+			return err
+		}
+		return nil
+	}, 0).SetSchedule(ScheduleFunc(func(t time.Time) bool {
+		return t.Second() == 0
+	})).SetRetryPolicy(ConstantBackoff(3, 0).SetRetryable(func(err error) bool {
+		return errors.Is(err, errTemporary)
+	}))
+	ctx := context.Background()
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	result.Store(errTemporary)
+	if err := job.RunAt(ctx, now); !errors.Is(err, errTemporary) || !job.IsRetrying() || job.Status() != JobStatusRetrying {
+		t.Fatal("retry must be scheduled", err, job.Status())
+	}
+	// schedule is ignored for retry
+	job.SetNextTime(now)
+	if job.RunAt(ctx, now.Add(time.Second)); calls.Load() != 2 || !job.IsRetrying() {
+		t.Fatal("job must be retried", calls.Load())
+	}
+	job.SetNextTime(now)
+	if job.RunAt(ctx, now.Add(time.Second*2)); calls.Load() != 3 || job.IsRetrying() || job.Status() != JobStatusFailed {
+		t.Fatal("retries must be exhausted", calls.Load(), job.Status())
+	}
+	job.SetNextTime(now)
+	if job.RunAt(ctx, now.Add(time.Second*3)); calls.Load() != 3 {
+		t.Fatal("job must wait for schedule")
+	}
+	result.Store(errFatal)
+	if job.RunAt(ctx, now.Add(time.Minute)); job.IsRetrying() || job.GetRuns() != 2 {
+		t.Fatal("error must not be retried", job.GetRuns())
+	}
+}
+
+func TestGroup_Retry(t *testing.T) {
+	var calls atomic.Int32
+	g := NewGroup(time.Millisecond*5, GroupModeConsistently)
+	g.AddJob(NewJob("test.retry.group", func(ctx context.Context, args ...any) error {
+		if calls.Add(1) < 3 {
+			return errors.New("temporary")
+		}
+		return nil
+	}, time.Hour).SetMaxRuns(1).SetRetryPolicy(ExponentialBackoff(5, time.Millisecond*10, 0)))
+	ctx := context.WithValue(context.Background(), "logger", &testLogger{})
+	ctx, cancel := context.WithTimeout(ctx, time.Millisecond*200)
+	defer cancel()
+	g.Schedule(ctx)
+	if calls.Load() != 3 {
+		t.Fatal("job must be retried until success", calls.Load())
+	}
+}
+
+func TestGroup_RetryParallel(t *testing.T) {
+	for _, mode := range []GroupMode{GroupModeAllParallel, 2} {
+		var calls atomic.Int32
+		g := NewGroup(time.Millisecond*5, mode)
+		job := NewJob("test.retry.parallel", func(ctx context.Context, args ...any) error {
+			calls.Add(1)
+			time.Sleep(time.Millisecond * 50)
+			return errors.New("temporary")
+		}, 0).SetRetryPolicy(ConstantBackoff(3, time.Millisecond*20))
+		g.AddOnce(job, time.Now())
+		ctx := context.WithValue(context.Background(), "logger", &testLogger{})
+		ctx, cancel := context.WithTimeout(ctx, time.Millisecond*400)
+		g.Schedule(ctx)
+		cancel()
+		waitIdle(job)
+		if calls.Load() != 3 || job.Status() != JobStatusExpired || g.GetJob("test.retry.parallel") != nil {
+			t.Fatal("job must be retried before expiration", mode, calls.Load(), job.Status())
+		}
+	}
+}
+
+func TestJob_RetrySuperseded(t *testing.T) {
+	errTemporary := errors.New("temporary")
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	schedule := ScheduleFunc(func(t time.Time) bool {
+		return t.Second() == 0
+	})
+	t.Run("replace", func(t *testing.T) {
+		var calls atomic.Int32
+		started := make(chan struct{})
+		job := NewJob("test.retry.replace", func(ctx context.Context, args ...any) error {
+			if calls.Add(1) == 1 {
+				close(started)
+				<-ctx.Done()
+				return ctx.Err()
+			}
+			return nil
+		}, 0).SetSchedule(schedule).SetRetryPolicy(ConstantBackoff(3, 0)).SetConcurrencyPolicy(ConcurrencyReplace)
+		done := make(chan struct{})
+		go func() {
+			defer close(done)
+			job.RunAt(context.Background(), now)
+		}()
+		<-started
+		if err := job.RunAt(context.Background(), now.Add(time.Minute)); err != nil {
+			t.Fatal(err)
+		}
+		<-done
+		if job.IsRetrying() || job.Status() != JobStatusSucceeded || job.LastError() != nil {
+			t.Fatal("replaced run must not be retried", job.Status(), job.LastError())
+		}
+		if job.SkipReason(now.Add(time.Minute+time.Second)) != "schedule does not match" {
+			t.Fatal("job must wait for schedule")
+		}
+	})
+	t.Run("queue", func(t *testing.T) {
+		var calls atomic.Int32
+		started, release := make(chan struct{}), make(chan struct{})
+		job := NewJob("test.retry.queue", func(ctx context.Context, args ...any) error {
+			if calls.Add(1) == 1 {
+				close(started)
+				<-release
+				return errTemporary
+			}
+			return nil
+		}, 0).SetSchedule(schedule).SetRetryPolicy(ConstantBackoff(3, 0)).SetConcurrencyPolicy(ConcurrencyQueue)
+		first, queued := make(chan struct{}), make(chan struct{})
+		go func() {
+			defer close(first)
+			job.RunAt(context.Background(), now)
+		}()
+		<-started
+		go func() {
+			defer close(queued)
+			job.RunAt(context.Background(), now.Add(time.Minute))
+		}()
+		for job.GetRuns() != 2 {
+			time.Sleep(time.Millisecond)
+		}
+		close(release)
+		<-first
+		<-queued
+		if calls.Load() != 2 || job.IsRetrying() || job.Status() != JobStatusSucceeded {
+			t.Fatal("failed run superseded by queued run must not be retried", calls.Load(), job.Status())
+		}
+	})
+}
@@ -0,0 +1,73 @@
+package gojob
+
+import (
+	"errors"
+	"time"
+)
+
+// Skip returned by callback when run is skipped. Skipped run is not failed and not retried
+// Skipped run doesn't change last error and last success time
+var Skip = errors.New("run skipped")
+
+// permanentError error of run which must not be retried
+type permanentError struct {
+	err error
+}
+
+// Error text of error
+func (e *permanentError) Error() string {
+	return e.err.Error()
+}
+
+// Unwrap original error
+func (e *permanentError) Unwrap() error {
+	return e.err
+}
+
+// Permanent wrap error of run which must not be retried regardless of retry policy
+func Permanent(err error) error {
+	if err == nil {
+		return nil
+	}
+	return &permanentError{err: err}
+}
+
+// IsPermanent check if error of run must not be retried
+func IsPermanent(err error) bool {
+	var e *permanentError
+	return errors.As(err, &e)
+}
+
+// retryAfterError error of run which must be retried after delay
+type retryAfterError struct {
+	err   error
+	delay time.Duration
+}
+
+// Error text of error
+func (e *retryAfterError) Error() string {
+	return e.err.Error()
+}
+
+// Unwrap original error
+func (e *retryAfterError) Unwrap() error {
+	return e.err
+}
+
+// RetryAfter wrap error of run which must be retried after delay
+// Delay and retryable check of retry policy are ignored. Max attempts of retry policy is checked when set
+func RetryAfter(err error, d time.Duration) error {
+	if err == nil {
+		return nil
+	}
+	return &retryAfterError{err: err, delay: d}
+}
+
+// RetryAfterDelay delay of retry requested by error of run. false if error is not returned by RetryAfter
+func RetryAfterDelay(err error) (time.Duration, bool) {
+	var e *retryAfterError
+	if errors.As(err, &e) {
+		return e.delay, true
+	}
+	return 0, false
+}
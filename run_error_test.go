@@ -0,0 +1,90 @@
+package gojob
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+)
+
+func TestRunError(t *testing.T) {
+	errBadRequest := errors.New("bad request")
+	err := fmt.Errorf("sync: %w", Permanent(errBadRequest))
+	if !IsPermanent(err) || !errors.Is(err, errBadRequest) || err.Error() != "sync: bad request" {
+		t.Fatal("wrong permanent error", err)
+	}
+	err = RetryAfter(errBadRequest, time.Minute)
+	if d, ok := RetryAfterDelay(err); !ok || d != time.Minute || !errors.Is(err, errBadRequest) {
+		t.Fatal("wrong retry after error", err)
+	}
+	if _, ok := RetryAfterDelay(errBadRequest); ok || IsPermanent(errBadRequest) {
+		t.Fatal("error must not be classified")
+	}
+	if Permanent(nil) != nil || RetryAfter(nil, time.Second) != nil {
+		t.Fatal("nil error must not be wrapped")
+	}
+}
+
+func TestJob_RunError(t *testing.T) {
+	errTemporary := errors.New("temporary")
+	var result error
+	job := NewJob("test.run.error", func(ctx context.Context, args ...any) error {
+		return result
+	}, 0).SetRetryPolicy(ConstantBackoff(3, 0))
+	ctx := context.Background()
+	now := time.Now()
+	t.Run("permanent", func(t *testing.T) {
+		result = Permanent(errTemporary)
+		if err := job.RunAt(ctx, now); !IsPermanent(err) || job.IsRetrying() || job.Status() != JobStatusFailed {
+			t.Fatal("permanent error must not be retried", err, job.Status())
+		}
+	})
+	t.Run("retry_after", func(t *testing.T) {
+		result = RetryAfter(errTemporary, time.Hour)
+		job.SetNextTime(time.Time{})
+		start := time.Now()
+		if job.RunAt(ctx, now); !job.IsRetrying() || job.SkipReason(start.Add(time.Minute)) == "" {
+			t.Fatal("retry must wait for requested delay")
+		}
+		if job.SkipReason(start.Add(time.Hour*2)) != "" {
+			t.Fatal("retry must start after requested delay")
+		}
+		job.SetNextTime(time.Time{})
+		if job.RunAt(ctx, now); !job.IsRetrying() {
+			t.Fatal("second attempt must be retried")
+		}
+		job.SetNextTime(time.Time{})
+		if job.RunAt(ctx, now); job.IsRetrying() {
+			t.Fatal("retries must be limited with max attempts")
+		}
+	})
+	t.Run("skip", func(t *testing.T) {
+		result = fmt.Errorf("no data: %w", Skip)
+		job.SetNextTime(time.Time{})
+		if err := job.RunAt(ctx, now); !errors.Is(err, Skip) || job.IsRetrying() || job.Status() != JobStatusSkipped {
+			t.Fatal("run must be skipped", err, job.Status())
+		}
+		if !errors.Is(job.LastError(), errTemporary) {
+			t.Fatal("skipped run must not change last error", job.LastError())
+		}
+	})
+}
+
+func TestGroup_Skip(t *testing.T) {
+	logger := &testLogger{}
+	g := NewGroup(time.Millisecond*10, GroupModeConsistently)
+	g.AddJob(NewJob("test.skip.group", func(ctx context.Context, args ...any) error {
+		return Skip
+	}, time.Millisecond))
+	ctx := context.WithValue(context.Background(), "logger", logger)
+	ctx, cancel := context.WithTimeout(ctx, time.Millisecond*50)
+	defer cancel()
+	g.Schedule(ctx)
+	if logger.contains(Skip.Error()) {
+		t.Fatal("skipped run must not be logged", logger.lines)
+	}
+	if g.GetJob("test.skip.group").Status() != JobStatusSkipped {
+		t.Fatal("wrong status")
+	}
+}
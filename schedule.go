@@ -0,0 +1,56 @@
+package gojob
+
+import "time"
+
+// Schedule define time slots when a job can be started
+type Schedule interface {
+	// Match check if job can be started at time
+	Match(t time.Time) bool
+}
+
+// AlignedSchedule schedule which define next attempt time itself instead of job repeat period
+type AlignedSchedule interface {
+	Schedule
+	// Next first start time after t
+	Next(t time.Time) time.Time
+}
+
+// Interval schedule repeated each period starting from anchor time
+// Start times are aligned to anchor and don't depend on the time of last run or process restart
+type Interval struct {
+	// Time of the first start
+	Anchor time.Time `yaml:"anchor" json:"anchor"`
+	// Repeat period
+	Every time.Duration `yaml:"every" json:"every"`
+}
+
+// Match check if interval is started
+func (i Interval) Match(t time.Time) bool {
+	return i.Every > 0 && !t.Before(i.Anchor)
+}
+
+// Next first start time after t
+func (i Interval) Next(t time.Time) time.Time {
+	if t.Before(i.Anchor) || i.Every <= 0 {
+		return i.Anchor
+	}
+	return i.Anchor.Add((t.Sub(i.Anchor)/i.Every + 1) * i.Every)
+}
+
+// EverySince create interval schedule
+// anchor - time of the first start
+// every - repeat period
+func EverySince(anchor time.Time, every time.Duration) Interval {
+	return Interval{
+		Anchor: anchor,
+		Every:  every,
+	}
+}
+
+// ScheduleFunc function adapter for Schedule interface
+type ScheduleFunc func(t time.Time) bool
+
+// Match check if job can be started at time
+func (f ScheduleFunc) Match(t time.Time) bool {
+	return f(t)
+}
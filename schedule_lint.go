@@ -0,0 +1,159 @@
+package gojob
+
+import (
+	"fmt"
+	"time"
+)
+
+// LintCode type of schedule warning
+type LintCode string
+
+const (
+	// LintUnreachable expression never fires
+	LintUnreachable LintCode = "unreachable"
+	// LintConflict two day parts of expression exclude each other
+	LintConflict LintCode = "conflict"
+	// LintRare expression does not fire every year
+	LintRare LintCode = "rare"
+	// LintResolution expression is finer than group repeat duration
+	LintResolution LintCode = "resolution"
+)
+
+const (
+	// lintCycleYears gregorian calendar repeats weekdays each 28 years between 1901 and 2099
+	lintCycleYears = 28
+	// lintCycleStart first year of simulated cycle
+	lintCycleStart = 2001
+)
+
+// part names in expression order
+var partNames = [9]string{
+	"millisecond", "second", "minute", "hour", "day of week",
+	"day of month", "week of month", "week of year", "month",
+}
+
+// LintWarning schedule expression warning
+type LintWarning struct {
+	// Type of warning
+	Code LintCode
+	// Numbers of expression parts (1-9) related to the warning
+	Parts []int
+	// Human readable description
+	Message string
+}
+
+// String warning as text
+func (w LintWarning) String() string {
+	return string(w.Code) + ": " + w.Message
+}
+
+// Lint check expression for unreachable and suspicious combinations
+// tick - group repeat duration, 0 disables resolution check
+func (s ScheduleExpression) Lint(tick time.Duration) ([]LintWarning, error) {
+	tp, err := s.Parse()
+	if err != nil {
+		return nil, err
+	}
+	return tp.Lint(tick), nil
+}
+
+// Lint check time part for unreachable and suspicious combinations
+// tick - group repeat duration, 0 disables resolution check
+func (t TimePart) Lint(tick time.Duration) []LintWarning {
+	var warnings []LintWarning
+	tf := t.fields()
+	// day parts which could conflict with each other
+	var dayParts []int
+	for i := partDayOfWeek; i <= partMonth; i++ {
+		if len(*tf[i]) > 0 {
+			dayParts = append(dayParts, i)
+		}
+	}
+	for i := 0; i < len(dayParts); i++ {
+		for j := i + 1; j < len(dayParts); j++ {
+			if t.countDays(dayParts[i], dayParts[j]) == 0 {
+				warnings = append(warnings, LintWarning{
+					Code:    LintConflict,
+					Parts:   []int{dayParts[i] + 1, dayParts[j] + 1},
+					Message: fmt.Sprintf("%s and %s never match the same day", partNames[dayParts[i]], partNames[dayParts[j]]),
+				})
+			}
+		}
+	}
+	if years := t.countDays(dayParts...); years == 0 {
+		warnings = append(warnings, LintWarning{
+			Code:    LintUnreachable,
+			Parts:   partNumbers(dayParts),
+			Message: "expression never fires",
+		})
+	} else if years < lintCycleYears {
+		warnings = append(warnings, LintWarning{
+			Code:    LintRare,
+			Parts:   partNumbers(dayParts),
+			Message: fmt.Sprintf("expression fires only in %v of %v years", years, lintCycleYears),
+		})
+	}
+	for i := partMillisecond; i <= partHour; i++ {
+		if len(*tf[i]) == 0 {
+			continue
+		}
+		if resolution := partResolutions[i]; tick > resolution {
+			warnings = append(warnings, LintWarning{
+				Code:    LintResolution,
+				Parts:   []int{i + 1},
+				Message: fmt.Sprintf("%s resolution %v is finer than repeat duration %v", partNames[i], resolution, tick),
+			})
+		}
+		break
+	}
+	return warnings
+}
+
+// countDays number of years in calendar cycle with at least one day matching all provided day parts
+func (t TimePart) countDays(parts ...int) int {
+	var years int
+	day := time.Date(lintCycleStart, time.January, 1, 0, 0, 0, 0, time.UTC)
+	for year := lintCycleStart; year < lintCycleStart+lintCycleYears; year++ {
+		for day.Year() == year {
+			if t.matchParts(day, parts...) {
+				years++
+				day = time.Date(year+1, time.January, 1, 0, 0, 0, 0, time.UTC)
+				break
+			}
+			day = day.AddDate(0, 0, 1)
+		}
+	}
+	return years
+}
+
+// matchParts check if time matches provided parts
+func (t TimePart) matchParts(tm time.Time, parts ...int) bool {
+	tf := t.fields()
+	for _, part := range parts {
+		values := *tf[part]
+		if len(values) == 0 {
+			continue
+		}
+		var found bool
+		value := partValue(part, tm)
+		for i := range values {
+			if values[i] == value {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// partNumbers convert part indexes to part numbers
+func partNumbers(parts []int) []int {
+	numbers := make([]int, len(parts))
+	for i := range parts {
+		numbers[i] = parts[i] + 1
+	}
+	return numbers
+}
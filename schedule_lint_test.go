@@ -0,0 +1,87 @@
+package gojob
+
+import (
+	"slices"
+	"testing"
+	"time"
+)
+
+func hasLintWarning(warnings []LintWarning, code LintCode, parts ...int) bool {
+	for i := range warnings {
+		if warnings[i].Code == code && (len(parts) == 0 || slices.Equal(warnings[i].Parts, parts)) {
+			return true
+		}
+	}
+	return false
+}
+
+func TestScheduleExpression_Lint(t *testing.T) {
+	t.Run("ok", func(t *testing.T) {
+		warnings, err := ScheduleExpression("- 0 */5 9-18 1-5 - - - -").Lint(time.Second)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(warnings) > 0 {
+			t.Fatal("must be without warnings", warnings)
+		}
+	})
+	t.Run("day_of_month_and_month", func(t *testing.T) {
+		warnings, err := ScheduleExpression("- - - - - 30 - - 2").Lint(time.Second)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !hasLintWarning(warnings, LintConflict, 6, 9) {
+			t.Fatal("must be day of month and month conflict", warnings)
+		}
+		if !hasLintWarning(warnings, LintUnreachable) {
+			t.Fatal("must be unreachable", warnings)
+		}
+	})
+	t.Run("day_of_month_and_week_of_month", func(t *testing.T) {
+		warnings, err := ScheduleExpression("- - - - - 31 1 - -").Lint(time.Second)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !hasLintWarning(warnings, LintConflict, 6, 7) {
+			t.Fatal("must be day of month and week of month conflict", warnings)
+		}
+	})
+	t.Run("rare", func(t *testing.T) {
+		warnings, err := ScheduleExpression("- - - - - 29 - - 2").Lint(time.Second)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !hasLintWarning(warnings, LintRare) || hasLintWarning(warnings, LintUnreachable) {
+			t.Fatal("must be rare", warnings)
+		}
+		warnings, err = ScheduleExpression("- - - - - - - 53 1").Lint(time.Second)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !hasLintWarning(warnings, LintRare) {
+			t.Fatal("iso week 53 in january must be rare", warnings)
+		}
+	})
+	t.Run("resolution", func(t *testing.T) {
+		warnings, err := ScheduleExpression("- */5 - - - - - - -").Lint(time.Minute)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !hasLintWarning(warnings, LintResolution, 2) {
+			t.Fatal("must be resolution warning", warnings)
+		}
+		warnings, err = ScheduleExpression("- - 0 - - - - - -").Lint(time.Second)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if hasLintWarning(warnings, LintResolution) {
+			t.Fatal("must be without resolution warning", warnings)
+		}
+	})
+	t.Run("parse_error", func(t *testing.T) {
+		_, err := ScheduleExpression("- //* - - - - - - -").Lint(time.Second)
+		if err == nil {
+			t.Fatal("must be parse error")
+		}
+	})
+}
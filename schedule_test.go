@@ -0,0 +1,64 @@
+package gojob
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestInterval(t *testing.T) {
+	anchor := time.Date(2026, 1, 5, 3, 0, 0, 0, time.UTC)
+	interval := EverySince(anchor, time.Hour*24*7)
+	t.Run("before_anchor", func(t *testing.T) {
+		if interval.Match(anchor.Add(-time.Second)) {
+			t.Fatal("must not match before anchor")
+		}
+		if !interval.Next(anchor.Add(-time.Hour)).Equal(anchor) {
+			t.Fatal("next must be anchor")
+		}
+	})
+	t.Run("next", func(t *testing.T) {
+		next := interval.Next(time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC))
+		if !next.Equal(time.Date(2026, 10, 26, 3, 0, 0, 0, time.UTC)) {
+			t.Fatal("wrong next time", next)
+		}
+		next = interval.Next(anchor)
+		if !next.Equal(anchor.Add(time.Hour * 24 * 7)) {
+			t.Fatal("next after slot must be the following slot", next)
+		}
+	})
+	t.Run("since_epoch", func(t *testing.T) {
+		next := EverySince(time.Unix(0, 0), time.Minute*90).Next(time.Date(2026, 10, 19, 0, 10, 0, 0, time.UTC))
+		if !next.Equal(time.Date(2026, 10, 19, 1, 30, 0, 0, time.UTC)) {
+			t.Fatal("wrong next time", next)
+		}
+	})
+}
+
+func TestJob_AlignedSchedule(t *testing.T) {
+	var runs int
+	interval := EverySince(time.Unix(0, 0), time.Minute*90)
+	job := NewJob("aligned", func(ctx context.Context, args ...any) error {
+		runs++
+		return nil
+	}, time.Second).SetSchedule(interval)
+	if !job.nextAttemptAt.After(time.Now()) || job.nextAttemptAt.Sub(time.Unix(0, 0))%(time.Minute*90) != 0 {
+		t.Fatal("next attempt must be aligned to the next slot")
+	}
+	tick := time.Date(2026, 10, 19, 1, 30, 1, 0, time.UTC)
+	job.SetNextTime(time.Date(2026, 10, 19, 1, 30, 0, 0, time.UTC))
+	if err := job.RunAt(context.Background(), tick); err != nil {
+		t.Fatal(err)
+	}
+	if runs != 1 {
+		t.Fatal("job must run")
+	}
+	if !job.nextAttemptAt.Equal(time.Date(2026, 10, 19, 3, 0, 0, 0, time.UTC)) {
+		t.Fatal("next attempt must keep phase", job.nextAttemptAt)
+	}
+	// restart must not reset phase
+	restarted := NewJob("aligned", job.callback, time.Second).SetSchedule(interval)
+	if restarted.nextAttemptAt.Sub(time.Unix(0, 0))%(time.Minute*90) != 0 {
+		t.Fatal("restarted job must keep phase")
+	}
+}
@@ -0,0 +1,134 @@
+package gojob
+
+import (
+	"math"
+	"time"
+)
+
+// SolarEvent position of the sun during a day
+type SolarEvent int
+
+const (
+	// Sunrise upper edge of the sun appears on the horizon
+	Sunrise SolarEvent = iota
+	// Sunset upper edge of the sun disappears below the horizon
+	Sunset
+	// SolarNoon the sun is at the highest position
+	SolarNoon
+	// CivilDawn the sun is 6 degrees below the horizon before sunrise
+	CivilDawn
+	// CivilDusk the sun is 6 degrees below the horizon after sunset
+	CivilDusk
+)
+
+const (
+	// julian date of unix epoch
+	julianUnixEpoch = 2440587.5
+	// julian date of J2000.0 epoch
+	julianJ2000 = 2451545.0
+	// earth axial tilt in degrees
+	earthObliquity = 23.4397
+	// degrees to radians multiplier
+	degree = math.Pi / 180
+)
+
+// Solar schedule relative to the sun event at a location
+// Sun position is calculated offline with accuracy about one minute
+type Solar struct {
+	// Latitude in degrees, north is positive
+	Latitude float64 `yaml:"latitude" json:"latitude"`
+	// Longitude in degrees, east is positive
+	Longitude float64 `yaml:"longitude" json:"longitude"`
+	// Sun event
+	Event SolarEvent `yaml:"event" json:"event"`
+	// Offset from the event. Negative value means before event
+	Offset time.Duration `yaml:"offset" json:"offset"`
+	// Time zone of the location days. UTC if nil
+	Location *time.Location `yaml:"-" json:"-"`
+}
+
+// SetOffset set offset from the event
+func (s Solar) SetOffset(d time.Duration) Solar {
+	s.Offset = d
+	return s
+}
+
+// SetLocation set time zone of the location days
+func (s Solar) SetLocation(loc *time.Location) Solar {
+	s.Location = loc
+	return s
+}
+
+// At time of event with offset on the local day of t. false if there is no event on the day (polar day or night)
+func (s Solar) At(t time.Time) (time.Time, bool) {
+	loc := s.Location
+	if loc == nil {
+		loc = time.UTC
+	}
+	year, month, day := t.In(loc).Date()
+	// days from J2000.0 epoch
+	n := float64(time.Date(year, month, day, 0, 0, 0, 0, time.UTC).Sub(time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)) / (time.Hour * 24))
+	// mean solar time
+	j := n - s.Longitude/360
+	// solar mean anomaly
+	m := math.Mod(357.5291+0.98560028*j, 360)
+	// equation of the center
+	c := 1.9148*math.Sin(m*degree) + 0.02*math.Sin(2*m*degree) + 0.0003*math.Sin(3*m*degree)
+	// ecliptic longitude
+	l := math.Mod(m+c+180+102.9372, 360)
+	// solar transit
+	transit := julianJ2000 + j + 0.0053*math.Sin(m*degree) - 0.0069*math.Sin(2*l*degree)
+	if s.Event == SolarNoon {
+		return julianToTime(transit).Add(s.Offset).In(loc), true
+	}
+	elevation := -0.833
+	if s.Event == CivilDawn || s.Event == CivilDusk {
+		elevation = -6
+	}
+	// declination of the sun
+	sinDeclination := math.Sin(l*degree) * math.Sin(earthObliquity*degree)
+	cosDeclination := math.Cos(math.Asin(sinDeclination))
+	// hour angle
+	cosHourAngle := (math.Sin(elevation*degree) - math.Sin(s.Latitude*degree)*sinDeclination) /
+		(math.Cos(s.Latitude*degree) * cosDeclination)
+	if cosHourAngle < -1 || cosHourAngle > 1 {
+		return time.Time{}, false
+	}
+	hourAngle := math.Acos(cosHourAngle) / degree
+	if s.Event == Sunrise || s.Event == CivilDawn {
+		return julianToTime(transit - hourAngle/360).Add(s.Offset).In(loc), true
+	}
+	return julianToTime(transit + hourAngle/360).Add(s.Offset).In(loc), true
+}
+
+// Match check if the event happens on the local day of t
+func (s Solar) Match(t time.Time) bool {
+	_, ok := s.At(t)
+	return ok
+}
+
+// Next first event time after t
+func (s Solar) Next(t time.Time) time.Time {
+	for i := -1; i <= 366; i++ {
+		if event, ok := s.At(t.AddDate(0, 0, i)); ok && event.After(t) {
+			return event
+		}
+	}
+	return t.Add(time.Hour * 24)
+}
+
+// NewSolar create schedule relative to the sun event
+// latitude - degrees, north is positive
+// longitude - degrees, east is positive
+func NewSolar(latitude, longitude float64, event SolarEvent) Solar {
+	return Solar{
+		Latitude:  latitude,
+		Longitude: longitude,
+		Event:     event,
+	}
+}
+
+// julianToTime convert julian date to time
+func julianToTime(j float64) time.Time {
+	return time.Unix(0, int64((j-julianUnixEpoch)*float64(time.Hour*24))).UTC()
+}
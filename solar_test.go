@@ -0,0 +1,71 @@
+package gojob
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestSolar_At(t *testing.T) {
+	berlin := time.FixedZone("CEST", 2*60*60)
+	day := time.Date(2026, 6, 21, 12, 0, 0, 0, berlin)
+	cases := []struct {
+		name     string
+		solar    Solar
+		expected time.Time
+	}{
+		{"sunrise", NewSolar(52.52, 13.405, Sunrise), time.Date(2026, 6, 21, 4, 43, 0, 0, berlin)},
+		{"sunset", NewSolar(52.52, 13.405, Sunset), time.Date(2026, 6, 21, 21, 33, 0, 0, berlin)},
+		{"noon", NewSolar(52.52, 13.405, SolarNoon), time.Date(2026, 6, 21, 13, 8, 0, 0, berlin)},
+		{"sunset_offset", NewSolar(52.52, 13.405, Sunset).SetOffset(time.Minute * 30), time.Date(2026, 6, 21, 22, 3, 0, 0, berlin)},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			at, ok := c.solar.SetLocation(berlin).At(day)
+			if !ok {
+				t.Fatal("event must exist")
+			}
+			if diff := at.Sub(c.expected); diff > time.Minute*3 || diff < -time.Minute*3 {
+				t.Fatalf("expected %v got %v", c.expected, at)
+			}
+			if at.Location() != berlin {
+				t.Fatal("event must be in location time zone")
+			}
+		})
+	}
+}
+
+func TestSolar_Polar(t *testing.T) {
+	sunrise := NewSolar(69.65, 18.96, Sunrise)
+	if sunrise.Match(time.Date(2026, 12, 21, 12, 0, 0, 0, time.UTC)) {
+		t.Fatal("must be polar night")
+	}
+	if sunrise.Match(time.Date(2026, 6, 21, 12, 0, 0, 0, time.UTC)) {
+		t.Fatal("must be polar day")
+	}
+	next := sunrise.Next(time.Date(2026, 12, 21, 12, 0, 0, 0, time.UTC))
+	if next.Month() != time.January {
+		t.Fatal("first sunrise after polar night must be in january", next)
+	}
+}
+
+func TestSolar_Job(t *testing.T) {
+	var runs int
+	sunset := NewSolar(52.52, 13.405, Sunset).SetOffset(time.Minute * 30)
+	job := NewJob("lights.on", func(ctx context.Context, args ...any) error {
+		runs++
+		return nil
+	}, time.Minute).SetSchedule(sunset)
+	if !job.nextAttemptAt.After(time.Now()) {
+		t.Fatal("next attempt must be the next sunset")
+	}
+	event, _ := sunset.At(time.Date(2026, 10, 19, 0, 0, 0, 0, time.UTC))
+	job.SetNextTime(event)
+	if err := job.RunAt(context.Background(), event.Add(time.Second)); err != nil {
+		t.Fatal(err)
+	}
+	next, _ := sunset.At(time.Date(2026, 10, 20, 0, 0, 0, 0, time.UTC))
+	if runs != 1 || !job.nextAttemptAt.Equal(next) {
+		t.Fatal("next attempt must be the next day sunset", job.nextAttemptAt)
+	}
+}
@@ -0,0 +1,138 @@
+package gojob
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// load average file
+	procLoadAvg = "/proc/loadavg"
+	// memory info file
+	procMemInfo = "/proc/meminfo"
+	// max duration of port check when context has no deadline
+	portDialTimeout = time.Second
+)
+
+// ErrSystemNotSupported condition is not supported on the platform
+var ErrSystemNotSupported = errors.New("system condition is not supported on the platform")
+
+// FileExists condition is true when file or directory exists
+func FileExists(path string) Condition {
+	return NewCondition(OperatorAND).AddNamedContextExpression("file exists "+path, func(ctx context.Context, t time.Time) (bool, error) {
+		return fileExists(path)
+	})
+}
+
+// FileNotExists condition is true when file or directory does not exist
+func FileNotExists(path string) Condition {
+	return NewCondition(OperatorAND).AddNamedContextExpression("file not exists "+path, func(ctx context.Context, t time.Time) (bool, error) {
+		ok, err := fileExists(path)
+		return !ok && err == nil, err
+	})
+}
+
+// EnvEquals condition is true when environment variable is set to value
+func EnvEquals(name, value string) Condition {
+	return NewCondition(OperatorAND).AddNamedExpression("env "+name+" equals "+value, func() bool {
+		v, ok := os.LookupEnv(name)
+		return ok && v == value
+	})
+}
+
+// LoadAverageBelow condition is true when 1 minute load average is below threshold
+func LoadAverageBelow(threshold float64) Condition {
+	name := "load average below " + strconv.FormatFloat(threshold, 'f', -1, 64)
+	return NewCondition(OperatorAND).AddNamedContextExpression(name, func(ctx context.Context, t time.Time) (bool, error) {
+		load, err := readLoadAverage(procLoadAvg)
+		return err == nil && load < threshold, err
+	})
+}
+
+// FreeMemoryAbove condition is true when available memory in bytes is above threshold
+func FreeMemoryAbove(threshold uint64) Condition {
+	name := "free memory above " + strconv.FormatUint(threshold, 10)
+	return NewCondition(OperatorAND).AddNamedContextExpression(name, func(ctx context.Context, t time.Time) (bool, error) {
+		free, err := readAvailableMemory(procMemInfo)
+		return err == nil && free > threshold, err
+	})
+}
+
+// FreeDiskAbove condition is true when disk space available for the file system of path in bytes is above threshold
+func FreeDiskAbove(path string, threshold uint64) Condition {
+	name := "free disk " + path + " above " + strconv.FormatUint(threshold, 10)
+	return NewCondition(OperatorAND).AddNamedContextExpression(name, func(ctx context.Context, t time.Time) (bool, error) {
+		free, err := freeDisk(path)
+		return err == nil && free > threshold, err
+	})
+}
+
+// PortReachable condition is true when tcp port on localhost accepts connections
+func PortReachable(port int) Condition {
+	address := net.JoinHostPort("localhost", strconv.Itoa(port))
+	return NewCondition(OperatorAND).AddNamedContextExpression("port reachable "+address, func(ctx context.Context, t time.Time) (bool, error) {
+		dialer := net.Dialer{Timeout: portDialTimeout}
+		conn, err := dialer.DialContext(ctx, "tcp", address)
+		if err != nil {
+			return false, ctx.Err()
+		}
+		return true, conn.Close()
+	})
+}
+
+// fileExists check if file exists. Error is returned when existence is unknown
+func fileExists(path string) (bool, error) {
+	_, err := os.Stat(path)
+	if err == nil {
+		return true, nil
+	}
+	if errors.Is(err, os.ErrNotExist) {
+		return false, nil
+	}
+	return false, err
+}
+
+// readLoadAverage read 1 minute load average
+func readLoadAverage(path string) (float64, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return 0, err
+	}
+	fields := strings.Fields(string(data))
+	if len(fields) == 0 {
+		return 0, fmt.Errorf("%s: unexpected format", path)
+	}
+	return strconv.ParseFloat(fields[0], 64)
+}
+
+// readAvailableMemory read available memory in bytes
+func readAvailableMemory(path string) (uint64, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return 0, err
+	}
+	defer file.Close()
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < 2 || fields[0] != "MemAvailable:" {
+			continue
+		}
+		kb, err := strconv.ParseUint(fields[1], 10, 64)
+		if err != nil {
+			return 0, err
+		}
+		return kb * 1024, nil
+	}
+	if err = scanner.Err(); err != nil {
+		return 0, err
+	}
+	return 0, fmt.Errorf("%s: MemAvailable not found", path)
+}
@@ -0,0 +1,14 @@
+//go:build linux
+
+package gojob
+
+import "syscall"
+
+// freeDisk disk space in bytes available for unprivileged user on the file system of path
+func freeDisk(path string) (uint64, error) {
+	var stat syscall.Statfs_t
+	if err := syscall.Statfs(path, &stat); err != nil {
+		return 0, err
+	}
+	return stat.Bavail * uint64(stat.Bsize), nil
+}
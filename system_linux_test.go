@@ -0,0 +1,26 @@
+package gojob
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestSystemConditions(t *testing.T) {
+	check := func(cond Condition, result bool) {
+		t.Helper()
+		ok, err := cond.Evaluate(context.Background(), time.Now())
+		if err != nil || ok != result {
+			t.Fatal("wrong result", ok, err)
+		}
+	}
+	check(LoadAverageBelow(1e9), true)
+	check(LoadAverageBelow(0), false)
+	check(FreeMemoryAbove(0), true)
+	check(FreeMemoryAbove(1<<62), false)
+	check(FreeDiskAbove(t.TempDir(), 0), true)
+	check(FreeDiskAbove(t.TempDir(), 1<<62), false)
+	if _, err := FreeDiskAbove("/not/exists/path", 0).Evaluate(context.Background(), time.Now()); err == nil {
+		t.Fatal("must be error")
+	}
+}
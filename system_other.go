@@ -0,0 +1,8 @@
+//go:build !linux
+
+package gojob
+
+// freeDisk disk space is not supported on the platform
+func freeDisk(path string) (uint64, error) {
+	return 0, ErrSystemNotSupported
+}
@@ -0,0 +1,73 @@
+package gojob
+
+import (
+	"context"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestFileExists(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "maintenance.lock")
+	if FileExists(path).IsTrue() || !FileNotExists(path).IsTrue() {
+		t.Fatal("file must not exist")
+	}
+	if err := os.WriteFile(path, nil, 0600); err != nil {
+		t.Fatal(err)
+	}
+	if !FileExists(path).IsTrue() || FileNotExists(path).IsTrue() {
+		t.Fatal("file must exist")
+	}
+}
+
+func TestEnvEquals(t *testing.T) {
+	t.Setenv("GOJOB_TEST_ROLE", "primary")
+	if !EnvEquals("GOJOB_TEST_ROLE", "primary").IsTrue() {
+		t.Fatal("must be true")
+	}
+	if EnvEquals("GOJOB_TEST_ROLE", "replica").IsTrue() || EnvEquals("GOJOB_TEST_NOT_SET", "").IsTrue() {
+		t.Fatal("must be false")
+	}
+}
+
+func TestPortReachable(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	port := listener.Addr().(*net.TCPAddr).Port
+	cond := PortReachable(port)
+	if ok, err := cond.Evaluate(context.Background(), time.Now()); !ok || err != nil {
+		t.Fatal("port must be reachable", err)
+	}
+	listener.Close()
+	if ok, err := cond.Evaluate(context.Background(), time.Now()); ok || err != nil {
+		t.Fatal("port must not be reachable", err)
+	}
+}
+
+func TestReadSystemFiles(t *testing.T) {
+	dir := t.TempDir()
+	loadavg := filepath.Join(dir, "loadavg")
+	if err := os.WriteFile(loadavg, []byte("0.52 0.58 0.59 1/1024 4242\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if load, err := readLoadAverage(loadavg); err != nil || load != 0.52 {
+		t.Fatal("wrong load average", load, err)
+	}
+	meminfo := filepath.Join(dir, "meminfo")
+	if err := os.WriteFile(meminfo, []byte("MemTotal:       16384 kB\nMemFree:         1024 kB\nMemAvailable:    2048 kB\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if free, err := readAvailableMemory(meminfo); err != nil || free != 2048*1024 {
+		t.Fatal("wrong available memory", free, err)
+	}
+	if _, err := readAvailableMemory(loadavg); err == nil {
+		t.Fatal("must be format error")
+	}
+	if _, err := readLoadAverage(filepath.Join(dir, "missing")); err == nil {
+		t.Fatal("must be not exists error")
+	}
+}
@@ -3,6 +3,8 @@ package gojob
 import (
 	"errors"
 	"slices"
+	"strconv"
+	"strings"
 	"time"
 )
 
@@ -129,55 +131,128 @@ func (t TimePart) GetRepeatPeriod() time.Duration {
 	return time.Second
 }
 
-// ToCondition transform into condition
+// Expression format time part as schedule expression
+// Parsed expression is equal to time part with sorted unique values
+func (t TimePart) Expression() ScheduleExpression {
+	fields := t.fields()
+	parts := make([]string, len(fields))
+	for i := range fields {
+		parts[i] = formatValues(normalizeValues(*fields[i]), i)
+	}
+	return ScheduleExpression(strings.Join(parts, " "))
+}
+
+// formatValues format sorted unique values of time part field
+func formatValues(values []int16, field int) string {
+	if len(values) == 0 {
+		return "-"
+	}
+	// '*' is parsed as all slots of field, starting from 1 for day parts
+	first := int16(0)
+	if field > partHour {
+		first = 1
+	}
+	size := positions[field+1] - positions[field]
+	if len(values) == size && values[0] == first && values[len(values)-1] == first+int16(size)-1 {
+		return "*"
+	}
+	var b strings.Builder
+	for i := 0; i < len(values); {
+		j := i
+		for j+1 < len(values) && values[j+1] == values[j]+1 {
+			j++
+		}
+		if b.Len() > 0 {
+			b.WriteByte(',')
+		}
+		b.WriteString(strconv.Itoa(int(values[i])))
+		if j > i {
+			b.WriteString("-" + strconv.Itoa(int(values[j])))
+		}
+		i = j + 1
+	}
+	return b.String()
+}
+
+// ToCondition transform into condition which is true when time of check matches the schedule
+// Condition is serializable with schedule expression
 func (t TimePart) ToCondition() Condition {
-	cond := NewCondition(OperatorAND)
-	if len(t.Millisecond) > 0 {
-		cond = cond.AddExpression(func() bool {
-			return slices.Contains[[]int16, int16](t.Millisecond, int16(time.Now().UnixMilli()%1000))
-		})
+	return NewCondition(OperatorAND).AddSchedule(t)
+}
+
+// Match check if time matches all defined parts
+func (t TimePart) Match(tm time.Time) bool {
+	if len(t.Millisecond) > 0 && !slices.Contains[[]int16, int16](t.Millisecond, int16(tm.UnixMilli()%1000)) {
+		return false
 	}
-	if len(t.Second) > 0 {
-		cond = cond.AddExpression(func() bool {
-			return slices.Contains[[]int16, int16](t.Second, int16(time.Now().Second()&0xFF))
-		})
+	if len(t.Second) > 0 && !slices.Contains[[]int16, int16](t.Second, int16(tm.Second()&0xFF)) {
+		return false
 	}
-	if len(t.Minute) > 0 {
-		cond = cond.AddExpression(func() bool {
-			return slices.Contains[[]int16, int16](t.Minute, int16(time.Now().Minute()&0xFF))
-		})
+	if len(t.Minute) > 0 && !slices.Contains[[]int16, int16](t.Minute, int16(tm.Minute()&0xFF)) {
+		return false
 	}
-	if len(t.Hour) > 0 {
-		cond = cond.AddExpression(func() bool {
-			return slices.Contains[[]int16, int16](t.Hour, int16(time.Now().Hour()&0xFF))
-		})
+	if len(t.Hour) > 0 && !slices.Contains[[]int16, int16](t.Hour, int16(tm.Hour()&0xFF)) {
+		return false
 	}
-	if len(t.DayOfWeek) > 0 {
-		cond = cond.AddExpression(func() bool {
-			return slices.Contains[[]int16, int16](t.DayOfWeek, int16(time.Now().Weekday())&0xFF)
-		})
+	if len(t.DayOfWeek) > 0 && !slices.Contains[[]int16, int16](t.DayOfWeek, int16(tm.Weekday())&0xFF) {
+		return false
 	}
-	if len(t.DayOfMonth) > 0 {
-		cond = cond.AddExpression(func() bool {
-			return slices.Contains[[]int16, int16](t.DayOfMonth, int16(time.Now().Day()&0xFF))
-		})
+	if len(t.DayOfMonth) > 0 && !slices.Contains[[]int16, int16](t.DayOfMonth, int16(tm.Day()&0xFF)) {
+		return false
 	}
-	if len(t.WeekOfMonth) > 0 {
-		cond = cond.AddExpression(func() bool {
-			day := time.Now().Day()
-			return slices.Contains[[]int16, int16](t.WeekOfMonth, int16((day/7+1)&0xFF))
-		})
+	if len(t.WeekOfMonth) > 0 && !slices.Contains[[]int16, int16](t.WeekOfMonth, int16((tm.Day()/7+1)&0xFF)) {
+		return false
 	}
 	if len(t.WeekOfYear) > 0 {
-		cond = cond.AddExpression(func() bool {
-			_, week := time.Now().ISOWeek()
-			return slices.Contains[[]int16, int16](t.WeekOfYear, int16(week&0xFF))
-		})
+		_, week := tm.ISOWeek()
+		if !slices.Contains[[]int16, int16](t.WeekOfYear, int16(week&0xFF)) {
+			return false
+		}
 	}
-	if len(t.Month) > 0 {
-		cond = cond.AddExpression(func() bool {
-			return slices.Contains[[]int16, int16](t.Month, int16(time.Now().Month()&0xFF))
-		})
+	if len(t.Month) > 0 && !slices.Contains[[]int16, int16](t.Month, int16(tm.Month()&0xFF)) {
+		return false
+	}
+	return true
+}
+
+// indexes of time part fields in expression order
+const (
+	partMillisecond = iota
+	partSecond
+	partMinute
+	partHour
+	partDayOfWeek
+	partDayOfMonth
+	partWeekOfMonth
+	partWeekOfYear
+	partMonth
+)
+
+// partResolutions duration of one time slot for intraday parts
+var partResolutions = [4]time.Duration{time.Millisecond, time.Second, time.Minute, time.Hour}
+
+// partValue value of time part field for time
+func partValue(part int, tm time.Time) int16 {
+	switch part {
+	case partMillisecond:
+		return int16(tm.UnixMilli() % 1000)
+	case partSecond:
+		return int16(tm.Second())
+	case partMinute:
+		return int16(tm.Minute())
+	case partHour:
+		return int16(tm.Hour())
+	case partDayOfWeek:
+		return int16(tm.Weekday())
+	case partDayOfMonth:
+		return int16(tm.Day())
+	case partWeekOfMonth:
+		return int16(tm.Day()/7 + 1)
+	case partWeekOfYear:
+		_, week := tm.ISOWeek()
+		return int16(week)
+	case partMonth:
+		return int16(tm.Month())
 	}
-	return cond
+	return -1
 }
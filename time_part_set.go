@@ -0,0 +1,199 @@
+package gojob
+
+import (
+	"errors"
+	"slices"
+)
+
+var (
+	// ErrTimePartEmpty result of operation does not contain any time slot
+	ErrTimePartEmpty = errors.New("time part does not contain any time slot")
+	// ErrTimePartNotRepresentable result of operation can't be described by a single time part
+	ErrTimePartNotRepresentable = errors.New("result can't be represented as a single time part")
+)
+
+// timePartBounds min and max value of each time part field in expression order
+// day of week starts from 0 because time.Weekday for sunday is 0
+var timePartBounds = [9][2]int16{
+	{0, 999}, {0, 59}, {0, 59}, {0, 23}, {0, 7}, {1, 31}, {1, 5}, {1, 53}, {1, 12},
+}
+
+// fields pointers to time part fields in expression order
+func (t *TimePart) fields() [9]*[]int16 {
+	return [9]*[]int16{
+		&t.Millisecond, &t.Second, &t.Minute, &t.Hour, &t.DayOfWeek,
+		&t.DayOfMonth, &t.WeekOfMonth, &t.WeekOfYear, &t.Month,
+	}
+}
+
+// clone deep copy of time part with sorted unique values
+func (t TimePart) clone() TimePart {
+	var r TimePart
+	tf, rf := t.fields(), r.fields()
+	for i := range rf {
+		*rf[i] = normalizeValues(*tf[i])
+	}
+	return r
+}
+
+// Union join all time slots of both time parts
+// Result is exact only when parts differ in one field or one part contains the other
+func (t TimePart) Union(o TimePart) (TimePart, error) {
+	if t.covers(o) {
+		return t.clone(), nil
+	}
+	if o.covers(t) {
+		return o.clone(), nil
+	}
+	tf, of := t.fields(), o.fields()
+	diff := -1
+	for i := range tf {
+		if !equalValues(*tf[i], *of[i], i) {
+			if diff != -1 {
+				return TimePart{}, ErrTimePartNotRepresentable
+			}
+			diff = i
+		}
+	}
+	r := t.clone()
+	rf := r.fields()
+	if len(*tf[diff]) == 0 || len(*of[diff]) == 0 {
+		*rf[diff] = nil
+	} else {
+		*rf[diff] = normalizeValues(append(slices.Clone(*tf[diff]), *of[diff]...))
+	}
+	return r, nil
+}
+
+// Intersect keep only time slots present in both time parts
+func (t TimePart) Intersect(o TimePart) (TimePart, error) {
+	var r TimePart
+	tf, of, rf := t.fields(), o.fields(), r.fields()
+	for i := range rf {
+		switch {
+		case len(*tf[i]) == 0:
+			*rf[i] = normalizeValues(*of[i])
+		case len(*of[i]) == 0:
+			*rf[i] = normalizeValues(*tf[i])
+		default:
+			*rf[i] = intersectValues(*tf[i], *of[i])
+			if len(*rf[i]) == 0 {
+				return TimePart{}, ErrTimePartEmpty
+			}
+		}
+	}
+	return r, nil
+}
+
+// Subtract remove time slots of o from time part
+// Result is exact only when o covers the time part in all fields except one
+func (t TimePart) Subtract(o TimePart) (TimePart, error) {
+	if !Overlaps(t, o) {
+		return t.clone(), nil
+	}
+	tf, of := t.fields(), o.fields()
+	diff := -1
+	for i := range tf {
+		if !coversValues(*of[i], *tf[i], i) {
+			if diff != -1 {
+				return TimePart{}, ErrTimePartNotRepresentable
+			}
+			diff = i
+		}
+	}
+	if diff == -1 {
+		return TimePart{}, ErrTimePartEmpty
+	}
+	r := t.clone()
+	rf := r.fields()
+	*rf[diff] = subtractValues(expandValues(*tf[diff], diff), *of[diff])
+	return r, nil
+}
+
+// Overlaps check if two time parts share at least one time slot
+// Fields are compared independently so calendar impossible combinations are not detected
+func Overlaps(a, b TimePart) bool {
+	af, bf := a.fields(), b.fields()
+	for i := range af {
+		if len(*af[i]) == 0 || len(*bf[i]) == 0 {
+			continue
+		}
+		if len(intersectValues(*af[i], *bf[i])) == 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// covers check if time part contains every time slot of o
+func (t TimePart) covers(o TimePart) bool {
+	tf, of := t.fields(), o.fields()
+	for i := range tf {
+		if !coversValues(*tf[i], *of[i], i) {
+			return false
+		}
+	}
+	return true
+}
+
+// expand empty field to all possible values
+func expandValues(values []int16, field int) []int16 {
+	if len(values) > 0 {
+		return normalizeValues(values)
+	}
+	result := make([]int16, 0, timePartBounds[field][1]-timePartBounds[field][0]+1)
+	for v := timePartBounds[field][0]; v <= timePartBounds[field][1]; v++ {
+		result = append(result, v)
+	}
+	return result
+}
+
+// coversValues check if field values a contains all field values b
+func coversValues(a, b []int16, field int) bool {
+	if len(a) == 0 {
+		return true
+	}
+	for _, v := range expandValues(b, field) {
+		if !slices.Contains(a, v) {
+			return false
+		}
+	}
+	return true
+}
+
+// equalValues check if field values describe the same set
+func equalValues(a, b []int16, field int) bool {
+	return coversValues(a, b, field) && coversValues(b, a, field)
+}
+
+// normalizeValues sorted copy without duplicates
+func normalizeValues(values []int16) []int16 {
+	if len(values) == 0 {
+		return nil
+	}
+	result := slices.Clone(values)
+	slices.Sort(result)
+	return slices.Compact(result)
+}
+
+// intersectValues values present in both slices
+func intersectValues(a, b []int16) []int16 {
+	var result []int16
+	for _, v := range normalizeValues(a) {
+		if slices.Contains(b, v) {
+			result = append(result, v)
+		}
+	}
+	return result
+}
+
+// subtractValues values of a not present in b
+func subtractValues(a, b []int16) []int16 {
+	var result []int16
+	for _, v := range normalizeValues(a) {
+		if !slices.Contains(b, v) {
+			result = append(result, v)
+		}
+	}
+	return result
+}
@@ -0,0 +1,137 @@
+package gojob
+
+import (
+	"errors"
+	"slices"
+	"testing"
+	"time"
+)
+
+func mustParse(t *testing.T, exp ScheduleExpression) TimePart {
+	tp, err := exp.Parse()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return tp
+}
+
+func TestTimePart_Union(t *testing.T) {
+	t.Run("one_field", func(t *testing.T) {
+		tp, err := mustParse(t, "- - - 9-11 1-5 - - - -").Union(mustParse(t, "- - - 13-17 1-5 - - - -"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !slices.Equal(tp.Hour, []int16{9, 10, 11, 13, 14, 15, 16, 17}) {
+			t.Fatal("wrong hours", tp.Hour)
+		}
+		if len(tp.DayOfWeek) != 5 {
+			t.Fatal("day of week must be kept")
+		}
+	})
+	t.Run("contains", func(t *testing.T) {
+		tp, err := mustParse(t, "- - - 9 - - - - -").Union(mustParse(t, "- - 0 9 1 - - - -"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(tp.Minute) != 0 || len(tp.DayOfWeek) != 0 || !slices.Equal(tp.Hour, []int16{9}) {
+			t.Fatal("wider part must be returned")
+		}
+	})
+	t.Run("not_representable", func(t *testing.T) {
+		_, err := mustParse(t, "- - 0 9 - - - - -").Union(mustParse(t, "- - 30 10 - - - - -"))
+		if !errors.Is(err, ErrTimePartNotRepresentable) {
+			t.Fatal("must be not representable error")
+		}
+	})
+}
+
+func TestTimePart_Intersect(t *testing.T) {
+	t.Run("ok", func(t *testing.T) {
+		tp, err := mustParse(t, "- - - 9-17 - - - - -").Intersect(mustParse(t, "- - 0 12-20 1-5 - - - -"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !slices.Equal(tp.Hour, []int16{12, 13, 14, 15, 16, 17}) {
+			t.Fatal("wrong hours", tp.Hour)
+		}
+		if !slices.Equal(tp.Minute, []int16{0}) || len(tp.DayOfWeek) != 5 {
+			t.Fatal("wrong minute or day of week")
+		}
+	})
+	t.Run("empty", func(t *testing.T) {
+		_, err := mustParse(t, "- - - 1-5 - - - - -").Intersect(mustParse(t, "- - - 6-9 - - - - -"))
+		if !errors.Is(err, ErrTimePartEmpty) {
+			t.Fatal("must be empty error")
+		}
+	})
+}
+
+func TestTimePart_Subtract(t *testing.T) {
+	t.Run("business_hours_minus_lunch", func(t *testing.T) {
+		tp, err := mustParse(t, "- - - 9-17 1-5 - - - -").Subtract(mustParse(t, "- - - 12 - - - - -"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !slices.Equal(tp.Hour, []int16{9, 10, 11, 13, 14, 15, 16, 17}) {
+			t.Fatal("wrong hours", tp.Hour)
+		}
+		if tp.Match(time.Date(2026, 10, 19, 12, 15, 0, 0, time.UTC)) {
+			t.Fatal("lunch must be excluded")
+		}
+		if !tp.Match(time.Date(2026, 10, 19, 13, 15, 0, 0, time.UTC)) {
+			t.Fatal("monday afternoon must match")
+		}
+	})
+	t.Run("wildcard_field", func(t *testing.T) {
+		tp, err := mustParse(t, "- - - - - - - - -").Subtract(mustParse(t, "- - - 0-22 - - - - -"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !slices.Equal(tp.Hour, []int16{23}) {
+			t.Fatal("wrong hours", tp.Hour)
+		}
+	})
+	t.Run("no_overlap", func(t *testing.T) {
+		tp, err := mustParse(t, "- - 0 9 - - - - -").Subtract(mustParse(t, "- - 30 10 - - - - -"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !slices.Equal(tp.Minute, []int16{0}) || !slices.Equal(tp.Hour, []int16{9}) {
+			t.Fatal("time part must be unchanged")
+		}
+	})
+	t.Run("empty", func(t *testing.T) {
+		_, err := mustParse(t, "- - 0 9 - - - - -").Subtract(mustParse(t, "- - - 9 - - - - -"))
+		if !errors.Is(err, ErrTimePartEmpty) {
+			t.Fatal("must be empty error")
+		}
+	})
+	t.Run("not_representable", func(t *testing.T) {
+		_, err := mustParse(t, "- - - 9-17 - - - - -").Subtract(mustParse(t, "- - 0-29 12 - - - - -"))
+		if !errors.Is(err, ErrTimePartNotRepresentable) {
+			t.Fatal("must be not representable error")
+		}
+	})
+}
+
+func TestOverlaps(t *testing.T) {
+	if !Overlaps(mustParse(t, "- - */15 * - - - - -"), mustParse(t, "- - 0-10 3 - - - - -")) {
+		t.Fatal("must overlap at 03:00")
+	}
+	if Overlaps(mustParse(t, "- - */15 * - - - - -"), mustParse(t, "- - 1-14 3 - - - - -")) {
+		t.Fatal("must not overlap")
+	}
+	if !Overlaps(mustParse(t, "- - - - - - - - -"), mustParse(t, "- - 1 - - - - - -")) {
+		t.Fatal("wildcard must overlap")
+	}
+}
+
+func TestTimePart_Match(t *testing.T) {
+	tp := mustParse(t, "- 0 30 9 1 19 - - 10")
+	if !tp.Match(time.Date(2026, 10, 19, 9, 30, 0, 0, time.UTC)) {
+		t.Fatal("must match")
+	}
+	if tp.Match(time.Date(2026, 10, 19, 9, 31, 0, 0, time.UTC)) {
+		t.Fatal("must not match")
+	}
+}
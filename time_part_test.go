@@ -3,6 +3,7 @@ package gojob
 import (
 	"context"
 	"log"
+	"slices"
 	"testing"
 	"time"
 )
@@ -354,3 +355,24 @@ func TestTimePart_GetRepeatPeriod(t *testing.T) {
 		}
 	})
 }
+
+func TestTimePart_Expression(t *testing.T) {
+	for exp, formatted := range map[ScheduleExpression]ScheduleExpression{
+		"- - - - - - - - -":                "- - - - - - - - -",
+		"* * * * * * * * *":                "* * * * * * * * *",
+		"*/250 0 0-30/10 9-17 1-5 - - - *": "0,250,500,750 0 0,10,20,30 9-17 1-5 - - - *",
+		"1 2-4,5-9 * 1-20 * 3,5 - * */3,6": "1 2-9 * 1-20 * 3,5 - * 1,4,6-7,10",
+	} {
+		tp := mustParse(t, exp)
+		if tp.Expression() != formatted {
+			t.Fatal("wrong expression", exp, tp.Expression())
+		}
+		normalized, parsed := tp.clone(), mustParse(t, formatted).clone()
+		nf, pf := normalized.fields(), parsed.fields()
+		for i := range nf {
+			if !slices.Equal(*nf[i], *pf[i]) {
+				t.Fatal("formatted expression must be parsed to the same time part", exp, partNames[i])
+			}
+		}
+	}
+}
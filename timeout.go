@@ -0,0 +1,80 @@
+package gojob
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// ErrJobTimeout run of job exceeded timeout
+var ErrJobTimeout = errors.New("job timeout")
+
+// SetTimeout set max duration of run. 0 - unlimited
+// Context of the run is cancelled after timeout and the run returns ErrJobTimeout
+// without waiting for the callback
+func (j *Job) SetTimeout(d time.Duration) *Job {
+	j.m.Lock()
+	defer j.m.Unlock()
+	j.timeout = d
+	return j
+}
+
+// GetTimeout get max duration of run
+func (j *Job) GetTimeout() time.Duration {
+	j.m.Lock()
+	defer j.m.Unlock()
+	return j.timeout
+}
+
+// runTimeout run callback until the deadline of ctx
+// Timed out run is counted as running until the callback returns
+func (j *Job) runTimeout(ctx context.Context, run *jobRun, timeout time.Duration, retry bool, arg ...any) error {
+	errTimeout := fmt.Errorf("job: %s: %w after %s", j.name, ErrJobTimeout, timeout)
+	result := make(chan error, 1)
+	go func() {
+		err := j.callback(ctx, arg...)
+		if errors.Is(err, context.DeadlineExceeded) && errors.Is(ctx.Err(), context.DeadlineExceeded) {
+			err = errTimeout
+		}
+		j.finish(run, err, retry)
+		result <- err
+	}()
+	select {
+	case err := <-result:
+		return err
+	case <-ctx.Done():
+		// cancelled run and run finished at the deadline are finished by callback
+		if !errors.Is(ctx.Err(), context.DeadlineExceeded) || !j.timedOut(run, errTimeout, retry) {
+			return <-result
+		}
+	}
+	return errTimeout
+}
+
+// timedOut save outcome of the run exceeded timeout while callback is in progress and schedule retry if retry is true
+// Only the last reserved run is retried, outcome of replaced run is not saved
+// Returns false if the run is already finished
+func (j *Job) timedOut(run *jobRun, err error, retry bool) bool {
+	j.m.Lock()
+	defer j.m.Unlock()
+	j.om.Lock()
+	select {
+	case <-run.done:
+		j.om.Unlock()
+		return false
+	default:
+	}
+	run.timedOut = true
+	latest := run.seq == j.seq
+	if !run.replaced {
+		j.lastDuration = time.Since(run.start)
+		j.lastError = err
+		j.status = JobStatusTimedOut
+	}
+	j.om.Unlock()
+	if retry && latest {
+		j.retry(err)
+	}
+	return true
+}
@@ -0,0 +1,142 @@
+package gojob
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// waitIdle wait until callback of the job returns
+func waitIdle(job *Job) {
+	for job.IsRunning() {
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func TestJob_SetTimeout(t *testing.T) {
+	release := make(chan struct{})
+	job := NewJob("test.timeout", func(ctx context.Context, args ...any) error {
+		if len(args) > 0 {
+			// callback ignores context
+			<-release
+			return nil
+		}
+		<-ctx.Done()
+		return ctx.Err()
+	}, 0).SetTimeout(time.Millisecond * 20)
+	start := time.Now()
+	err := job.Run(context.Background())
+	if !errors.Is(err, ErrJobTimeout) || !errors.Is(job.LastError(), ErrJobTimeout) {
+		t.Fatal("run must time out", err)
+	}
+	if waitIdle(job); job.Status() != JobStatusTimedOut {
+		t.Fatal("wrong status", job.Status())
+	}
+	err = job.Run(context.Background(), true)
+	if !errors.Is(err, ErrJobTimeout) || time.Since(start) > time.Millisecond*200 {
+		t.Fatal("run must not wait for callback after timeout", err)
+	}
+	if !job.IsRunning() || job.Status() != JobStatusRunning || !errors.Is(job.LastError(), ErrJobTimeout) {
+		t.Fatal("timed out run must be running until callback returns", job.Status())
+	}
+	close(release)
+	if waitIdle(job); job.Status().String() != "timed out" || !errors.Is(job.LastError(), ErrJobTimeout) {
+		t.Fatal("outcome of timed out run must be kept", job.Status(), job.LastError())
+	}
+	job.SetTimeout(0)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if err = job.Run(ctx); !errors.Is(err, context.Canceled) || job.Status() != JobStatusFailed {
+		t.Fatal("cancelled run must fail", err, job.Status())
+	}
+}
+
+func TestJob_TimeoutForbid(t *testing.T) {
+	var calls atomic.Int32
+	release := make(chan struct{})
+	job := NewJob("test.timeout.forbid", func(ctx context.Context, args ...any) error {
+		// callback ignores context
+		if calls.Add(1) == 1 {
+			<-release
+		}
+		return nil
+	}, 0).SetTimeout(time.Millisecond * 20).SetConcurrencyPolicy(ConcurrencyForbid)
+	ctx := context.Background()
+	now := time.Now()
+	if err := job.RunAt(ctx, now); !errors.Is(err, ErrJobTimeout) {
+		t.Fatal("run must time out", err)
+	}
+	if err := job.RunAt(ctx, now.Add(time.Second)); err != nil || calls.Load() != 1 {
+		t.Fatal("run must be skipped while timed out callback is in progress", calls.Load())
+	}
+	close(release)
+	waitIdle(job)
+	if err := job.RunAt(ctx, now.Add(time.Second*2)); err != nil || calls.Load() != 2 {
+		t.Fatal("job must run after callback returns", err, calls.Load())
+	}
+}
+
+func TestGroup_TimeoutForbid(t *testing.T) {
+	var concurrent, maxConcurrent, calls atomic.Int32
+	g := NewGroup(time.Millisecond*5, GroupModeAllParallel)
+	g.AddJob(NewJob("test.timeout.forbid.group", func(ctx context.Context, args ...any) error {
+		calls.Add(1)
+		n := concurrent.Add(1)
+		for m := maxConcurrent.Load(); n > m && !maxConcurrent.CompareAndSwap(m, n); m = maxConcurrent.Load() {
+		}
+		// callback ignores context
+		time.Sleep(time.Millisecond * 50)
+		concurrent.Add(-1)
+		return nil
+	}, time.Millisecond).SetTimeout(time.Millisecond * 10).SetConcurrencyPolicy(ConcurrencyForbid))
+	ctx, cancel := context.WithTimeout(context.WithValue(context.Background(), "logger", &testLogger{}), time.Millisecond*150)
+	defer cancel()
+	g.Schedule(ctx)
+	if maxConcurrent.Load() != 1 || calls.Load() < 2 {
+		t.Fatal("timed out callbacks must not overlap", maxConcurrent.Load(), calls.Load())
+	}
+}
+
+func TestGroup_TimeoutQueue(t *testing.T) {
+	var ticks atomic.Int32
+	release := make(chan struct{})
+	g := NewGroup(time.Millisecond*10, GroupModeConsistently)
+	hung := NewJob("test.timeout.queue.hung", func(ctx context.Context, args ...any) error {
+		// callback ignores context
+		<-release
+		return nil
+	}, time.Millisecond).SetTimeout(time.Millisecond * 10).SetConcurrencyPolicy(ConcurrencyQueue)
+	g.AddJob(hung, NewJob("test.timeout.queue.tick", func(ctx context.Context, args ...any) error {
+		ticks.Add(1)
+		return nil
+	}, time.Millisecond))
+	ctx, cancel := context.WithTimeout(context.WithValue(context.Background(), "logger", &testLogger{}), time.Millisecond*300)
+	defer cancel()
+	g.Schedule(ctx)
+	close(release)
+	waitIdle(hung)
+	if ticks.Load() < 15 {
+		t.Fatal("queued run must not block scheduler", ticks.Load())
+	}
+}
+
+func TestGroup_JobTimeout(t *testing.T) {
+	logger := &testLogger{}
+	g := NewGroup(time.Millisecond*10, GroupModeConsistently)
+	g.AddJob(NewJob("test.timeout.group", func(ctx context.Context, args ...any) error {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(time.Second):
+			return nil
+		}
+	}, time.Hour).SetTimeout(time.Millisecond * 20))
+	ctx, cancel := context.WithTimeout(context.WithValue(context.Background(), "logger", logger), time.Millisecond*100)
+	defer cancel()
+	g.Schedule(ctx)
+	if !logger.contains("job: test.timeout.group: job timeout after 20ms") {
+		t.Fatal("timeout must be logged", logger.lines)
+	}
+}